@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 
+	infobloxinstall "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/install"
 	cfcmd "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/cmd"
 	cfdnsrecord "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/controller/dnsrecord"
 
@@ -89,8 +90,19 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			if err := controller.AddToScheme(scheme); err != nil {
 				return fmt.Errorf("could not update manager scheme: %w", err)
 			}
+			if err := infobloxinstall.AddToScheme(scheme); err != nil {
+				return fmt.Errorf("could not update manager scheme: %w", err)
+			}
 
 			dnsRecordCtrlOpts.Completed().Apply(&cfdnsrecord.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyZoneCacheTTL(&cfdnsrecord.DefaultAddOptions.ZoneCacheTTL)
+			configFileOpts.Completed().ApplyZoneCreation(&cfdnsrecord.DefaultAddOptions.ZoneCreation)
+			configFileOpts.Completed().ApplyChangeSchedule(&cfdnsrecord.DefaultAddOptions.ChangeSchedule)
+			configFileOpts.Completed().ApplyServiceRestart(&cfdnsrecord.DefaultAddOptions.ServiceRestart)
+			configFileOpts.Completed().ApplySoftDelete(&cfdnsrecord.DefaultAddOptions.SoftDelete)
+			configFileOpts.Completed().ApplyDeletionBudget(&cfdnsrecord.DefaultAddOptions.DeletionBudget)
+			configFileOpts.Completed().ApplyDriftDetection(&cfdnsrecord.DefaultAddOptions.DriftDetection)
+			configFileOpts.Completed().ApplyConflicts(&cfdnsrecord.DefaultAddOptions.Conflicts)
 
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				return fmt.Errorf("could not add controllers to manager: %w", err)
@@ -31,7 +31,7 @@ bash "${PROJECT_ROOT}"/vendor/k8s.io/code-generator/generate-internal-groups.sh
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/client/componentconfig \
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis \
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis \
-  "config:v1alpha1" \
+  "config:v1alpha1 infoblox:v1alpha1" \
   --go-header-file "${PROJECT_ROOT}/vendor/github.com/gardener/gardener/hack/LICENSE_BOILERPLATE.txt"
 
 bash "${PROJECT_ROOT}"/vendor/k8s.io/code-generator/generate-internal-groups.sh \
@@ -39,6 +39,6 @@ bash "${PROJECT_ROOT}"/vendor/k8s.io/code-generator/generate-internal-groups.sh
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/client/componentconfig \
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis \
   github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis \
-  "config:v1alpha1" \
-  --extra-peer-dirs=github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config,github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,github.com/gardener/gardener/extensions/pkg/controller/healthcheck/config/v1alpha1 \
+  "config:v1alpha1 infoblox:v1alpha1" \
+  --extra-peer-dirs=github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config,github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config/v1alpha1,github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox,github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,github.com/gardener/gardener/extensions/pkg/controller/healthcheck/config/v1alpha1 \
   --go-header-file "${PROJECT_ROOT}/vendor/github.com/gardener/gardener/hack/LICENSE_BOILERPLATE.txt"
@@ -28,4 +28,99 @@ type ControllerConfiguration struct {
 	// ClientConnection specifies the kubeconfig file and client connection
 	// settings for the proxy server to use when communicating with the apiserver.
 	ClientConnection *componentbaseconfig.ClientConnectionConfiguration
+	// ZoneCacheTTL is the time the managed zones of a grid and view are cached. A zero duration disables caching.
+	ZoneCacheTTL *metav1.Duration
+	// ZoneCreation configures the automatic creation of missing authoritative zones. Zones are only created if it is set.
+	ZoneCreation *ZoneCreationConfiguration
+	// ChangeSchedule restricts changes of records to a maintenance window. Changes outside of the window are submitted
+	// as scheduled tasks running at the next begin of the window. Changes are applied immediately if it is not set.
+	ChangeSchedule *ChangeScheduleConfiguration
+	// ServiceRestart enables restarts of the DNS services of a grid after changes. Services are not restarted if it
+	// is not set.
+	ServiceRestart *ServiceRestartConfiguration
+	// SoftDelete configures the soft deletion of records, which disables the records of deleted DNSRecords instead of
+	// deleting them. Records replaced by changed values are always deleted.
+	SoftDelete *SoftDeleteConfiguration
+	// DeletionBudget limits the number of records deleted per operation and per time window and zone. Deletions are
+	// not limited if it is not set.
+	DeletionBudget *DeletionBudgetConfiguration
+	// DriftDetection enables the periodic comparison of reconciled DNSRecords with the records in the grid. Drift is
+	// not detected if it is not set.
+	DriftDetection *DriftDetectionConfiguration
+	// Conflicts enables the detection of records not written by the extension before a record set is written into
+	// a zone. Conflicts are only detected for DNSRecords with a conflict policy if it is not set.
+	Conflicts *ConflictConfiguration
+}
+
+// ConflictConfiguration configures the handling of records not written by the extension.
+type ConflictConfiguration struct {
+	// Policy specifies how records with the name and type of a record set are handled which have not been written by
+	// the extension, either Fail, Adopt, or Overwrite.
+	Policy string
+	// OwnerAttribute is the extensible attribute tagging records as written by the extension.
+	OwnerAttribute string
+}
+
+// DriftDetectionConfiguration configures the detection of record sets changed or deleted outside of the extension.
+type DriftDetectionConfiguration struct {
+	// ResyncPeriod is the interval in which the SOA serials of the zones are polled. The DNSRecords of a zone are
+	// compared with the records in the grid if its serial has changed.
+	ResyncPeriod *metav1.Duration
+	// FullResyncPeriod is the interval in which DNSRecords are compared with the records in the grid even if the
+	// serials of their zones have not changed. A zero duration only compares them after changes of the serials.
+	FullResyncPeriod *metav1.Duration
+	// Policy specifies how drifted record sets are handled, either Report or Repair.
+	Policy string
+	// ZonePolicies overrides the policy for record sets in the given zones. Zones can be qualified with a view (view/zone).
+	ZonePolicies map[string]string
+}
+
+// DeletionBudgetConfiguration limits the number of deleted records.
+type DeletionBudgetConfiguration struct {
+	// MaxPerOperation is the maximum number of records deleted by one reconciliation or deletion of a DNSRecord.
+	MaxPerOperation int
+	// MaxPerWindow is the maximum number of records deleted in a zone within the window.
+	MaxPerWindow int
+	// Window is the time window of MaxPerWindow.
+	Window *metav1.Duration
+}
+
+// SoftDeleteConfiguration configures the soft deletion of records.
+type SoftDeleteConfiguration struct {
+	// Enabled specifies whether records of all DNSRecords are soft-deleted. It can be overridden per DNSRecord.
+	Enabled bool
+	// Retention is the time soft-deleted records are kept before they are purged.
+	Retention *metav1.Duration
+	// DeletionAttribute is the extensible attribute holding the time a record has been soft-deleted.
+	DeletionAttribute string
+}
+
+// ServiceRestartConfiguration configures the restarts of the DNS services of a grid after changes.
+type ServiceRestartConfiguration struct {
+	// MinInterval is the minimum time between two restarts of the DNS services of a grid.
+	MinInterval *metav1.Duration
+}
+
+// ChangeScheduleConfiguration is the maintenance window in which changes of records are applied.
+type ChangeScheduleConfiguration struct {
+	// Begin is the begin of the window in the format HHMMSS+ZONE, e.g. 220000+0100.
+	Begin string
+	// End is the end of the window in the format HHMMSS+ZONE, e.g. 230000+0100.
+	End string
+}
+
+// ZoneCreationConfiguration configures the automatic creation of missing authoritative zones.
+type ZoneCreationConfiguration struct {
+	// ParentDomains are the domains below which zones may be created. A domain can be qualified with a view (view/domain).
+	ParentDomains []string
+	// GridPrimaries are the names of the grid members serving created zones as primaries.
+	GridPrimaries []string
+	// GridSecondaries are the names of the grid members serving created zones as secondaries.
+	GridSecondaries []string
+	// NSGroup is the name server group assigned to created zones. It takes precedence over the grid members.
+	NSGroup string
+	// OwnerAttribute is the extensible attribute tagging created zones as owned by the extension.
+	OwnerAttribute string
+	// DeleteWhenEmpty specifies whether created zones are deleted again when their last record is deleted.
+	DeleteWhenEmpty bool
 }
@@ -15,9 +15,73 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_ControllerConfiguration sets defaults for the ControllerConfiguration.
+func SetDefaults_ControllerConfiguration(obj *ControllerConfiguration) {
+	if obj.ZoneCacheTTL == nil {
+		obj.ZoneCacheTTL = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
+// SetDefaults_ZoneCreationConfiguration sets defaults for the ZoneCreationConfiguration.
+func SetDefaults_ZoneCreationConfiguration(obj *ZoneCreationConfiguration) {
+	if obj.OwnerAttribute == "" {
+		obj.OwnerAttribute = "Gardener Owner"
+	}
+}
+
+// SetDefaults_ServiceRestartConfiguration sets defaults for the ServiceRestartConfiguration.
+func SetDefaults_ServiceRestartConfiguration(obj *ServiceRestartConfiguration) {
+	if obj.MinInterval == nil {
+		obj.MinInterval = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_SoftDeleteConfiguration sets defaults for the SoftDeleteConfiguration.
+func SetDefaults_SoftDeleteConfiguration(obj *SoftDeleteConfiguration) {
+	if obj.Retention == nil {
+		obj.Retention = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+	if obj.DeletionAttribute == "" {
+		obj.DeletionAttribute = "Gardener Deletion Timestamp"
+	}
+}
+
+// SetDefaults_DeletionBudgetConfiguration sets defaults for the DeletionBudgetConfiguration.
+func SetDefaults_DeletionBudgetConfiguration(obj *DeletionBudgetConfiguration) {
+	if obj.Window == nil {
+		obj.Window = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
+// SetDefaults_DriftDetectionConfiguration sets defaults for the DriftDetectionConfiguration.
+func SetDefaults_DriftDetectionConfiguration(obj *DriftDetectionConfiguration) {
+	if obj.ResyncPeriod == nil {
+		obj.ResyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.FullResyncPeriod == nil {
+		obj.FullResyncPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.Policy == "" {
+		obj.Policy = "Report"
+	}
+}
+
+// SetDefaults_ConflictConfiguration sets defaults for the ConflictConfiguration.
+func SetDefaults_ConflictConfiguration(obj *ConflictConfiguration) {
+	if obj.Policy == "" {
+		obj.Policy = "Fail"
+	}
+	if obj.OwnerAttribute == "" {
+		obj.OwnerAttribute = "Gardener Owner"
+	}
+}
@@ -30,6 +30,136 @@ type ControllerConfiguration struct {
 	// settings for the proxy server to use when communicating with the apiserver.
 	// +optional
 	ClientConnection *componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:"clientConnection,omitempty"`
+	// ZoneCacheTTL is the time the managed zones of a grid and view are cached. A zero duration disables caching.
+	// Defaults to 5m.
+	// +optional
+	ZoneCacheTTL *metav1.Duration `json:"zoneCacheTTL,omitempty"`
+	// ZoneCreation configures the automatic creation of missing authoritative zones. Zones are only created if it is set.
+	// +optional
+	ZoneCreation *ZoneCreationConfiguration `json:"zoneCreation,omitempty"`
+	// ChangeSchedule restricts changes of records to a maintenance window. Changes outside of the window are submitted
+	// as scheduled tasks running at the next begin of the window. Changes are applied immediately if it is not set.
+	// +optional
+	ChangeSchedule *ChangeScheduleConfiguration `json:"changeSchedule,omitempty"`
+	// ServiceRestart enables restarts of the DNS services of a grid after changes. Services are not restarted if it
+	// is not set.
+	// +optional
+	ServiceRestart *ServiceRestartConfiguration `json:"serviceRestart,omitempty"`
+	// SoftDelete configures the soft deletion of records, which disables the records of deleted DNSRecords instead of
+	// deleting them. Records replaced by changed values are always deleted.
+	// +optional
+	SoftDelete *SoftDeleteConfiguration `json:"softDelete,omitempty"`
+	// DeletionBudget limits the number of records deleted per operation and per time window and zone. Deletions are
+	// not limited if it is not set.
+	// +optional
+	DeletionBudget *DeletionBudgetConfiguration `json:"deletionBudget,omitempty"`
+	// DriftDetection enables the periodic comparison of reconciled DNSRecords with the records in the grid. Drift is
+	// not detected if it is not set.
+	// +optional
+	DriftDetection *DriftDetectionConfiguration `json:"driftDetection,omitempty"`
+	// Conflicts enables the detection of records not written by the extension before a record set is written into
+	// a zone. Conflicts are only detected for DNSRecords with a conflict policy if it is not set.
+	// +optional
+	Conflicts *ConflictConfiguration `json:"conflicts,omitempty"`
+}
+
+// ConflictConfiguration configures the handling of records not written by the extension.
+type ConflictConfiguration struct {
+	// Policy specifies how records with the name and type of a record set are handled which have not been written by
+	// the extension. With Fail, the DNSRecord fails. With Adopt, the records are tagged as written by the extension
+	// and updated. With Overwrite, the records are replaced. Defaults to Fail.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// OwnerAttribute is the extensible attribute tagging records as written by the extension. It must be defined in
+	// the grid with type string. Defaults to "Gardener Owner".
+	// +optional
+	OwnerAttribute string `json:"ownerAttribute,omitempty"`
+}
+
+// DriftDetectionConfiguration configures the detection of record sets changed or deleted outside of the extension.
+type DriftDetectionConfiguration struct {
+	// ResyncPeriod is the interval in which the SOA serials of the zones are polled. The DNSRecords of a zone are
+	// compared with the records in the grid if its serial has changed. Defaults to 5m.
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
+	// FullResyncPeriod is the interval in which DNSRecords are compared with the records in the grid even if the
+	// serials of their zones have not changed. A zero duration only compares them after changes of the serials.
+	// Defaults to 24h.
+	// +optional
+	FullResyncPeriod *metav1.Duration `json:"fullResyncPeriod,omitempty"`
+	// Policy specifies how drifted record sets are handled. With Report, drift is only reported by a condition,
+	// an event, and a metric. With Repair, the DNSRecord is reconciled again in addition. Defaults to Report.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// ZonePolicies overrides the policy for record sets in the given zones. Zones can be qualified with a view (view/zone).
+	// +optional
+	ZonePolicies map[string]string `json:"zonePolicies,omitempty"`
+}
+
+// DeletionBudgetConfiguration limits the number of deleted records.
+type DeletionBudgetConfiguration struct {
+	// MaxPerOperation is the maximum number of records deleted by one reconciliation or deletion of a DNSRecord.
+	// Zero disables the limit.
+	// +optional
+	MaxPerOperation int `json:"maxPerOperation,omitempty"`
+	// MaxPerWindow is the maximum number of records deleted in a zone within the window. Zero disables the limit.
+	// +optional
+	MaxPerWindow int `json:"maxPerWindow,omitempty"`
+	// Window is the time window of MaxPerWindow. Defaults to 1h.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// SoftDeleteConfiguration configures the soft deletion of records.
+type SoftDeleteConfiguration struct {
+	// Enabled specifies whether records of all DNSRecords are soft-deleted. It can be overridden per DNSRecord.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Retention is the time soft-deleted records are kept before they are purged. Defaults to 168h.
+	// +optional
+	Retention *metav1.Duration `json:"retention,omitempty"`
+	// DeletionAttribute is the extensible attribute holding the time a record has been soft-deleted.
+	// It must be defined in the grid with type string. Defaults to "Gardener Deletion Timestamp".
+	// +optional
+	DeletionAttribute string `json:"deletionAttribute,omitempty"`
+}
+
+// ServiceRestartConfiguration configures the restarts of the DNS services of a grid after changes.
+type ServiceRestartConfiguration struct {
+	// MinInterval is the minimum time between two restarts of the DNS services of a grid. Restarts requested within
+	// the interval are merged into one restart at its end. Defaults to 10m.
+	// +optional
+	MinInterval *metav1.Duration `json:"minInterval,omitempty"`
+}
+
+// ChangeScheduleConfiguration is the maintenance window in which changes of records are applied.
+type ChangeScheduleConfiguration struct {
+	// Begin is the begin of the window in the format HHMMSS+ZONE, e.g. 220000+0100.
+	Begin string `json:"begin"`
+	// End is the end of the window in the format HHMMSS+ZONE, e.g. 230000+0100.
+	End string `json:"end"`
+}
+
+// ZoneCreationConfiguration configures the automatic creation of missing authoritative zones.
+type ZoneCreationConfiguration struct {
+	// ParentDomains are the domains below which zones may be created. A domain can be qualified with a view (view/domain).
+	ParentDomains []string `json:"parentDomains"`
+	// GridPrimaries are the names of the grid members serving created zones as primaries.
+	// +optional
+	GridPrimaries []string `json:"gridPrimaries,omitempty"`
+	// GridSecondaries are the names of the grid members serving created zones as secondaries.
+	// +optional
+	GridSecondaries []string `json:"gridSecondaries,omitempty"`
+	// NSGroup is the name server group assigned to created zones. It takes precedence over the grid members.
+	// +optional
+	NSGroup string `json:"nsGroup,omitempty"`
+	// OwnerAttribute is the extensible attribute tagging created zones as owned by the extension.
+	// It must be defined in the grid. Defaults to "Gardener Owner".
+	// +optional
+	OwnerAttribute string `json:"ownerAttribute,omitempty"`
+	// DeleteWhenEmpty specifies whether created zones are deleted again when their last record is deleted.
+	// +optional
+	DeleteWhenEmpty bool `json:"deleteWhenEmpty,omitempty"`
 }
 
 // ProviderConfigManager contains configurations settings for the providerconfig.
@@ -26,6 +26,7 @@ import (
 
 	
 	config "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -39,6 +40,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ChangeScheduleConfiguration)(nil), (*config.ChangeScheduleConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChangeScheduleConfiguration_To_config_ChangeScheduleConfiguration(a.(*ChangeScheduleConfiguration), b.(*config.ChangeScheduleConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ChangeScheduleConfiguration)(nil), (*ChangeScheduleConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ChangeScheduleConfiguration_To_v1alpha1_ChangeScheduleConfiguration(a.(*config.ChangeScheduleConfiguration), b.(*ChangeScheduleConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConflictConfiguration)(nil), (*config.ConflictConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConflictConfiguration_To_config_ConflictConfiguration(a.(*ConflictConfiguration), b.(*config.ConflictConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConflictConfiguration)(nil), (*ConflictConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConflictConfiguration_To_v1alpha1_ConflictConfiguration(a.(*config.ConflictConfiguration), b.(*ConflictConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -49,11 +70,113 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeletionBudgetConfiguration)(nil), (*config.DeletionBudgetConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionBudgetConfiguration_To_config_DeletionBudgetConfiguration(a.(*DeletionBudgetConfiguration), b.(*config.DeletionBudgetConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DeletionBudgetConfiguration)(nil), (*DeletionBudgetConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DeletionBudgetConfiguration_To_v1alpha1_DeletionBudgetConfiguration(a.(*config.DeletionBudgetConfiguration), b.(*DeletionBudgetConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DriftDetectionConfiguration)(nil), (*config.DriftDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DriftDetectionConfiguration_To_config_DriftDetectionConfiguration(a.(*DriftDetectionConfiguration), b.(*config.DriftDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DriftDetectionConfiguration)(nil), (*DriftDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DriftDetectionConfiguration_To_v1alpha1_DriftDetectionConfiguration(a.(*config.DriftDetectionConfiguration), b.(*DriftDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceRestartConfiguration)(nil), (*config.ServiceRestartConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceRestartConfiguration_To_config_ServiceRestartConfiguration(a.(*ServiceRestartConfiguration), b.(*config.ServiceRestartConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ServiceRestartConfiguration)(nil), (*ServiceRestartConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ServiceRestartConfiguration_To_v1alpha1_ServiceRestartConfiguration(a.(*config.ServiceRestartConfiguration), b.(*ServiceRestartConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SoftDeleteConfiguration)(nil), (*config.SoftDeleteConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SoftDeleteConfiguration_To_config_SoftDeleteConfiguration(a.(*SoftDeleteConfiguration), b.(*config.SoftDeleteConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SoftDeleteConfiguration)(nil), (*SoftDeleteConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SoftDeleteConfiguration_To_v1alpha1_SoftDeleteConfiguration(a.(*config.SoftDeleteConfiguration), b.(*SoftDeleteConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneCreationConfiguration)(nil), (*config.ZoneCreationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneCreationConfiguration_To_config_ZoneCreationConfiguration(a.(*ZoneCreationConfiguration), b.(*config.ZoneCreationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ZoneCreationConfiguration)(nil), (*ZoneCreationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ZoneCreationConfiguration_To_v1alpha1_ZoneCreationConfiguration(a.(*config.ZoneCreationConfiguration), b.(*ZoneCreationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_ChangeScheduleConfiguration_To_config_ChangeScheduleConfiguration(in *ChangeScheduleConfiguration, out *config.ChangeScheduleConfiguration, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_ChangeScheduleConfiguration_To_config_ChangeScheduleConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ChangeScheduleConfiguration_To_config_ChangeScheduleConfiguration(in *ChangeScheduleConfiguration, out *config.ChangeScheduleConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChangeScheduleConfiguration_To_config_ChangeScheduleConfiguration(in, out, s)
+}
+
+func autoConvert_config_ChangeScheduleConfiguration_To_v1alpha1_ChangeScheduleConfiguration(in *config.ChangeScheduleConfiguration, out *ChangeScheduleConfiguration, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_config_ChangeScheduleConfiguration_To_v1alpha1_ChangeScheduleConfiguration is an autogenerated conversion function.
+func Convert_config_ChangeScheduleConfiguration_To_v1alpha1_ChangeScheduleConfiguration(in *config.ChangeScheduleConfiguration, out *ChangeScheduleConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ChangeScheduleConfiguration_To_v1alpha1_ChangeScheduleConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ConflictConfiguration_To_config_ConflictConfiguration(in *ConflictConfiguration, out *config.ConflictConfiguration, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.OwnerAttribute = in.OwnerAttribute
+	return nil
+}
+
+// Convert_v1alpha1_ConflictConfiguration_To_config_ConflictConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ConflictConfiguration_To_config_ConflictConfiguration(in *ConflictConfiguration, out *config.ConflictConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConflictConfiguration_To_config_ConflictConfiguration(in, out, s)
+}
+
+func autoConvert_config_ConflictConfiguration_To_v1alpha1_ConflictConfiguration(in *config.ConflictConfiguration, out *ConflictConfiguration, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.OwnerAttribute = in.OwnerAttribute
 	return nil
 }
 
+// Convert_config_ConflictConfiguration_To_v1alpha1_ConflictConfiguration is an autogenerated conversion function.
+func Convert_config_ConflictConfiguration_To_v1alpha1_ConflictConfiguration(in *config.ConflictConfiguration, out *ConflictConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ConflictConfiguration_To_v1alpha1_ConflictConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.ZoneCacheTTL = (*v1.Duration)(unsafe.Pointer(in.ZoneCacheTTL))
+	out.ZoneCreation = (*config.ZoneCreationConfiguration)(unsafe.Pointer(in.ZoneCreation))
+	out.ChangeSchedule = (*config.ChangeScheduleConfiguration)(unsafe.Pointer(in.ChangeSchedule))
+	out.ServiceRestart = (*config.ServiceRestartConfiguration)(unsafe.Pointer(in.ServiceRestart))
+	out.SoftDelete = (*config.SoftDeleteConfiguration)(unsafe.Pointer(in.SoftDelete))
+	out.DeletionBudget = (*config.DeletionBudgetConfiguration)(unsafe.Pointer(in.DeletionBudget))
+	out.DriftDetection = (*config.DriftDetectionConfiguration)(unsafe.Pointer(in.DriftDetection))
+	out.Conflicts = (*config.ConflictConfiguration)(unsafe.Pointer(in.Conflicts))
 	return nil
 }
 
@@ -64,6 +187,14 @@ func Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(
 
 func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.ZoneCacheTTL = (*v1.Duration)(unsafe.Pointer(in.ZoneCacheTTL))
+	out.ZoneCreation = (*ZoneCreationConfiguration)(unsafe.Pointer(in.ZoneCreation))
+	out.ChangeSchedule = (*ChangeScheduleConfiguration)(unsafe.Pointer(in.ChangeSchedule))
+	out.ServiceRestart = (*ServiceRestartConfiguration)(unsafe.Pointer(in.ServiceRestart))
+	out.SoftDelete = (*SoftDeleteConfiguration)(unsafe.Pointer(in.SoftDelete))
+	out.DeletionBudget = (*DeletionBudgetConfiguration)(unsafe.Pointer(in.DeletionBudget))
+	out.DriftDetection = (*DriftDetectionConfiguration)(unsafe.Pointer(in.DriftDetection))
+	out.Conflicts = (*ConflictConfiguration)(unsafe.Pointer(in.Conflicts))
 	return nil
 }
 
@@ -71,3 +202,127 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_DeletionBudgetConfiguration_To_config_DeletionBudgetConfiguration(in *DeletionBudgetConfiguration, out *config.DeletionBudgetConfiguration, s conversion.Scope) error {
+	out.MaxPerOperation = in.MaxPerOperation
+	out.MaxPerWindow = in.MaxPerWindow
+	out.Window = (*v1.Duration)(unsafe.Pointer(in.Window))
+	return nil
+}
+
+// Convert_v1alpha1_DeletionBudgetConfiguration_To_config_DeletionBudgetConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionBudgetConfiguration_To_config_DeletionBudgetConfiguration(in *DeletionBudgetConfiguration, out *config.DeletionBudgetConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionBudgetConfiguration_To_config_DeletionBudgetConfiguration(in, out, s)
+}
+
+func autoConvert_config_DeletionBudgetConfiguration_To_v1alpha1_DeletionBudgetConfiguration(in *config.DeletionBudgetConfiguration, out *DeletionBudgetConfiguration, s conversion.Scope) error {
+	out.MaxPerOperation = in.MaxPerOperation
+	out.MaxPerWindow = in.MaxPerWindow
+	out.Window = (*v1.Duration)(unsafe.Pointer(in.Window))
+	return nil
+}
+
+// Convert_config_DeletionBudgetConfiguration_To_v1alpha1_DeletionBudgetConfiguration is an autogenerated conversion function.
+func Convert_config_DeletionBudgetConfiguration_To_v1alpha1_DeletionBudgetConfiguration(in *config.DeletionBudgetConfiguration, out *DeletionBudgetConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DeletionBudgetConfiguration_To_v1alpha1_DeletionBudgetConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DriftDetectionConfiguration_To_config_DriftDetectionConfiguration(in *DriftDetectionConfiguration, out *config.DriftDetectionConfiguration, s conversion.Scope) error {
+	out.ResyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ResyncPeriod))
+	out.FullResyncPeriod = (*v1.Duration)(unsafe.Pointer(in.FullResyncPeriod))
+	out.Policy = in.Policy
+	out.ZonePolicies = *(*map[string]string)(unsafe.Pointer(&in.ZonePolicies))
+	return nil
+}
+
+// Convert_v1alpha1_DriftDetectionConfiguration_To_config_DriftDetectionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DriftDetectionConfiguration_To_config_DriftDetectionConfiguration(in *DriftDetectionConfiguration, out *config.DriftDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DriftDetectionConfiguration_To_config_DriftDetectionConfiguration(in, out, s)
+}
+
+func autoConvert_config_DriftDetectionConfiguration_To_v1alpha1_DriftDetectionConfiguration(in *config.DriftDetectionConfiguration, out *DriftDetectionConfiguration, s conversion.Scope) error {
+	out.ResyncPeriod = (*v1.Duration)(unsafe.Pointer(in.ResyncPeriod))
+	out.FullResyncPeriod = (*v1.Duration)(unsafe.Pointer(in.FullResyncPeriod))
+	out.Policy = in.Policy
+	out.ZonePolicies = *(*map[string]string)(unsafe.Pointer(&in.ZonePolicies))
+	return nil
+}
+
+// Convert_config_DriftDetectionConfiguration_To_v1alpha1_DriftDetectionConfiguration is an autogenerated conversion function.
+func Convert_config_DriftDetectionConfiguration_To_v1alpha1_DriftDetectionConfiguration(in *config.DriftDetectionConfiguration, out *DriftDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DriftDetectionConfiguration_To_v1alpha1_DriftDetectionConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceRestartConfiguration_To_config_ServiceRestartConfiguration(in *ServiceRestartConfiguration, out *config.ServiceRestartConfiguration, s conversion.Scope) error {
+	out.MinInterval = (*v1.Duration)(unsafe.Pointer(in.MinInterval))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceRestartConfiguration_To_config_ServiceRestartConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceRestartConfiguration_To_config_ServiceRestartConfiguration(in *ServiceRestartConfiguration, out *config.ServiceRestartConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceRestartConfiguration_To_config_ServiceRestartConfiguration(in, out, s)
+}
+
+func autoConvert_config_ServiceRestartConfiguration_To_v1alpha1_ServiceRestartConfiguration(in *config.ServiceRestartConfiguration, out *ServiceRestartConfiguration, s conversion.Scope) error {
+	out.MinInterval = (*v1.Duration)(unsafe.Pointer(in.MinInterval))
+	return nil
+}
+
+// Convert_config_ServiceRestartConfiguration_To_v1alpha1_ServiceRestartConfiguration is an autogenerated conversion function.
+func Convert_config_ServiceRestartConfiguration_To_v1alpha1_ServiceRestartConfiguration(in *config.ServiceRestartConfiguration, out *ServiceRestartConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ServiceRestartConfiguration_To_v1alpha1_ServiceRestartConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SoftDeleteConfiguration_To_config_SoftDeleteConfiguration(in *SoftDeleteConfiguration, out *config.SoftDeleteConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Retention = (*v1.Duration)(unsafe.Pointer(in.Retention))
+	out.DeletionAttribute = in.DeletionAttribute
+	return nil
+}
+
+// Convert_v1alpha1_SoftDeleteConfiguration_To_config_SoftDeleteConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SoftDeleteConfiguration_To_config_SoftDeleteConfiguration(in *SoftDeleteConfiguration, out *config.SoftDeleteConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SoftDeleteConfiguration_To_config_SoftDeleteConfiguration(in, out, s)
+}
+
+func autoConvert_config_SoftDeleteConfiguration_To_v1alpha1_SoftDeleteConfiguration(in *config.SoftDeleteConfiguration, out *SoftDeleteConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Retention = (*v1.Duration)(unsafe.Pointer(in.Retention))
+	out.DeletionAttribute = in.DeletionAttribute
+	return nil
+}
+
+// Convert_config_SoftDeleteConfiguration_To_v1alpha1_SoftDeleteConfiguration is an autogenerated conversion function.
+func Convert_config_SoftDeleteConfiguration_To_v1alpha1_SoftDeleteConfiguration(in *config.SoftDeleteConfiguration, out *SoftDeleteConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SoftDeleteConfiguration_To_v1alpha1_SoftDeleteConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneCreationConfiguration_To_config_ZoneCreationConfiguration(in *ZoneCreationConfiguration, out *config.ZoneCreationConfiguration, s conversion.Scope) error {
+	out.ParentDomains = *(*[]string)(unsafe.Pointer(&in.ParentDomains))
+	out.GridPrimaries = *(*[]string)(unsafe.Pointer(&in.GridPrimaries))
+	out.GridSecondaries = *(*[]string)(unsafe.Pointer(&in.GridSecondaries))
+	out.NSGroup = in.NSGroup
+	out.OwnerAttribute = in.OwnerAttribute
+	out.DeleteWhenEmpty = in.DeleteWhenEmpty
+	return nil
+}
+
+// Convert_v1alpha1_ZoneCreationConfiguration_To_config_ZoneCreationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneCreationConfiguration_To_config_ZoneCreationConfiguration(in *ZoneCreationConfiguration, out *config.ZoneCreationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneCreationConfiguration_To_config_ZoneCreationConfiguration(in, out, s)
+}
+
+func autoConvert_config_ZoneCreationConfiguration_To_v1alpha1_ZoneCreationConfiguration(in *config.ZoneCreationConfiguration, out *ZoneCreationConfiguration, s conversion.Scope) error {
+	out.ParentDomains = *(*[]string)(unsafe.Pointer(&in.ParentDomains))
+	out.GridPrimaries = *(*[]string)(unsafe.Pointer(&in.GridPrimaries))
+	out.GridSecondaries = *(*[]string)(unsafe.Pointer(&in.GridSecondaries))
+	out.NSGroup = in.NSGroup
+	out.OwnerAttribute = in.OwnerAttribute
+	out.DeleteWhenEmpty = in.DeleteWhenEmpty
+	return nil
+}
+
+// Convert_config_ZoneCreationConfiguration_To_v1alpha1_ZoneCreationConfiguration is an autogenerated conversion function.
+func Convert_config_ZoneCreationConfiguration_To_v1alpha1_ZoneCreationConfiguration(in *config.ZoneCreationConfiguration, out *ZoneCreationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ZoneCreationConfiguration_To_v1alpha1_ZoneCreationConfiguration(in, out, s)
+}
@@ -22,10 +22,43 @@ limitations under the License.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeScheduleConfiguration) DeepCopyInto(out *ChangeScheduleConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeScheduleConfiguration.
+func (in *ChangeScheduleConfiguration) DeepCopy() *ChangeScheduleConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeScheduleConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConflictConfiguration) DeepCopyInto(out *ConflictConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConflictConfiguration.
+func (in *ConflictConfiguration) DeepCopy() *ConflictConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConflictConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -35,6 +68,46 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(configv1alpha1.ClientConnectionConfiguration)
 		**out = **in
 	}
+	if in.ZoneCacheTTL != nil {
+		in, out := &in.ZoneCacheTTL, &out.ZoneCacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ZoneCreation != nil {
+		in, out := &in.ZoneCreation, &out.ZoneCreation
+		*out = new(ZoneCreationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChangeSchedule != nil {
+		in, out := &in.ChangeSchedule, &out.ChangeSchedule
+		*out = new(ChangeScheduleConfiguration)
+		**out = **in
+	}
+	if in.ServiceRestart != nil {
+		in, out := &in.ServiceRestart, &out.ServiceRestart
+		*out = new(ServiceRestartConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftDelete != nil {
+		in, out := &in.SoftDelete, &out.SoftDelete
+		*out = new(SoftDeleteConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionBudget != nil {
+		in, out := &in.DeletionBudget, &out.DeletionBudget
+		*out = new(DeletionBudgetConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = new(ConflictConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -55,3 +128,130 @@ func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionBudgetConfiguration) DeepCopyInto(out *DeletionBudgetConfiguration) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionBudgetConfiguration.
+func (in *DeletionBudgetConfiguration) DeepCopy() *DeletionBudgetConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionBudgetConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfiguration) DeepCopyInto(out *DriftDetectionConfiguration) {
+	*out = *in
+	if in.ResyncPeriod != nil {
+		in, out := &in.ResyncPeriod, &out.ResyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FullResyncPeriod != nil {
+		in, out := &in.FullResyncPeriod, &out.FullResyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ZonePolicies != nil {
+		in, out := &in.ZonePolicies, &out.ZonePolicies
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfiguration.
+func (in *DriftDetectionConfiguration) DeepCopy() *DriftDetectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceRestartConfiguration) DeepCopyInto(out *ServiceRestartConfiguration) {
+	*out = *in
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceRestartConfiguration.
+func (in *ServiceRestartConfiguration) DeepCopy() *ServiceRestartConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRestartConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftDeleteConfiguration) DeepCopyInto(out *SoftDeleteConfiguration) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftDeleteConfiguration.
+func (in *SoftDeleteConfiguration) DeepCopy() *SoftDeleteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftDeleteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneCreationConfiguration) DeepCopyInto(out *ZoneCreationConfiguration) {
+	*out = *in
+	if in.ParentDomains != nil {
+		in, out := &in.ParentDomains, &out.ParentDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GridPrimaries != nil {
+		in, out := &in.GridPrimaries, &out.GridPrimaries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GridSecondaries != nil {
+		in, out := &in.GridSecondaries, &out.GridSecondaries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneCreationConfiguration.
+func (in *ZoneCreationConfiguration) DeepCopy() *ZoneCreationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneCreationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
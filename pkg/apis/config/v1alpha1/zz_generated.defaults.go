@@ -29,5 +29,28 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&ControllerConfiguration{}, func(obj interface{}) { SetObjectDefaults_ControllerConfiguration(obj.(*ControllerConfiguration)) })
 	return nil
 }
+
+func SetObjectDefaults_ControllerConfiguration(in *ControllerConfiguration) {
+	SetDefaults_ControllerConfiguration(in)
+	if in.ZoneCreation != nil {
+		SetDefaults_ZoneCreationConfiguration(in.ZoneCreation)
+	}
+	if in.ServiceRestart != nil {
+		SetDefaults_ServiceRestartConfiguration(in.ServiceRestart)
+	}
+	if in.SoftDelete != nil {
+		SetDefaults_SoftDeleteConfiguration(in.SoftDelete)
+	}
+	if in.DeletionBudget != nil {
+		SetDefaults_DeletionBudgetConfiguration(in.DeletionBudget)
+	}
+	if in.DriftDetection != nil {
+		SetDefaults_DriftDetectionConfiguration(in.DriftDetection)
+	}
+	if in.Conflicts != nil {
+		SetDefaults_ConflictConfiguration(in.Conflicts)
+	}
+}
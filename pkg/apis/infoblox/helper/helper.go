@@ -0,0 +1,49 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"fmt"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DNSRecordConfigFromDNSRecord decodes the provider config of the given DNSRecord. If the DNSRecord has no
+// provider config, an empty DNSRecordConfig is returned.
+func DNSRecordConfigFromDNSRecord(decoder runtime.Decoder, dns *extensionsv1alpha1.DNSRecord) (*infoblox.DNSRecordConfig, error) {
+	config := &infoblox.DNSRecordConfig{}
+	if dns.Spec.ProviderConfig != nil && dns.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(dns.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of dnsrecord '%s': %w", kutil.ObjectName(dns), err)
+		}
+	}
+	return config, nil
+}
+
+// DNSRecordStatusFromDNSRecord decodes the provider status of the given DNSRecord. If the DNSRecord has no
+// provider status, an empty DNSRecordStatus is returned.
+func DNSRecordStatusFromDNSRecord(decoder runtime.Decoder, dns *extensionsv1alpha1.DNSRecord) (*infoblox.DNSRecordStatus, error) {
+	status := &infoblox.DNSRecordStatus{}
+	if dns.Status.ProviderStatus != nil && dns.Status.ProviderStatus.Raw != nil {
+		if _, _, err := decoder.Decode(dns.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, fmt.Errorf("could not decode providerStatus of dnsrecord '%s': %w", kutil.ObjectName(dns), err)
+		}
+	}
+	return status, nil
+}
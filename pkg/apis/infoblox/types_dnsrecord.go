@@ -0,0 +1,188 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig contains the Infoblox specific configuration of a DNSRecord.
+type DNSRecordConfig struct {
+	metav1.TypeMeta
+
+	// Alias manages the record set as Infoblox alias records (record:alias) following the target
+	// names given in the DNSRecord values. Only supported for DNSRecords of type CNAME.
+	Alias *AliasConfig
+	// Host manages the record set as addresses of an Infoblox host record (record:host), so that they are
+	// tracked in IPAM. Only supported for DNSRecords of type A and AAAA.
+	Host *HostConfig
+	// NextAvailableIP lets the grid allocate the address of the record set from a network or range instead of
+	// using the DNSRecord values. Only supported for DNSRecords of type A and AAAA.
+	NextAvailableIP *NextAvailableIPConfig
+	// Views are the DNS views to write the record set into, e.g. the internal and the external view of a split-horizon
+	// setup. They take precedence over the views configured in the secret.
+	Views []string
+	// SharedRecord manages the record set as shared records of an Infoblox shared record group, so that it appears in
+	// all zones associated with the group. Only supported for DNSRecords of type A, AAAA, CNAME and TXT.
+	SharedRecord *SharedRecordConfig
+	// ChangeSchedule restricts changes of the record set to a maintenance window. It takes precedence over the
+	// change schedule of the controller configuration.
+	ChangeSchedule *ChangeScheduleConfig
+	// SoftDelete specifies whether the records are disabled and tagged with the deletion time instead of being
+	// deleted with the DNSRecord. It takes precedence over the soft deletion setting of the controller configuration.
+	// Records replaced because the values of the DNSRecord have changed are always deleted, as they have been superseded,
+	// and so are host records and shared records.
+	SoftDelete *bool
+	// ConflictPolicy specifies how records with the name and type of the record set are handled which have not been
+	// written by the extension, either Fail, Adopt, or Overwrite. It takes precedence over the conflict policy of
+	// the controller configuration.
+	ConflictPolicy string
+	// NameServers are the glue addresses of the name servers DNSRecords of type NS delegate to. Name servers within the
+	// delegated domain need glue addresses, other name servers are written without addresses.
+	NameServers []NameServer
+}
+
+// AliasConfig contains the settings for Infoblox alias records.
+type AliasConfig struct {
+	// TargetType is the type of the records the alias resolves to, e.g. A or AAAA.
+	TargetType string
+}
+
+// NameServer is a name server with its glue addresses.
+type NameServer struct {
+	// Name is the host name of the name server.
+	Name string
+	// Addresses are the IPv4 and IPv6 addresses of the name server.
+	Addresses []string
+}
+
+// HostConfig contains the settings for Infoblox host records.
+type HostConfig struct {
+	// NetworkView is the network view of the host addresses. The network view of the DNS view is used if it is empty.
+	NetworkView string
+	// DHCP configures the host addresses for DHCP if set.
+	DHCP *HostDHCPConfig
+}
+
+// HostDHCPConfig contains the DHCP settings of host addresses.
+type HostDHCPConfig struct {
+	// MAC is the MAC address of IPv4 host addresses.
+	MAC string
+	// DUID is the DHCP unique identifier of IPv6 host addresses.
+	DUID string
+}
+
+// SharedRecordConfig contains the settings for Infoblox shared records.
+type SharedRecordConfig struct {
+	// Group is the shared record group holding the records. It must be associated with the zone of the DNSRecord.
+	// If it is empty, the group already holding shared records with the name of the DNSRecord is used.
+	Group string
+}
+
+// ChangeScheduleConfig is the maintenance window in which changes of the record set are applied. Changes outside of
+// the window are submitted as scheduled tasks running at the next begin of the window.
+type ChangeScheduleConfig struct {
+	// Begin is the begin of the window in the format HHMMSS+ZONE, e.g. 220000+0100.
+	Begin string
+	// End is the end of the window in the format HHMMSS+ZONE, e.g. 230000+0100.
+	End string
+}
+
+// NextAvailableIPConfig contains the settings for allocating the next available address.
+type NextAvailableIPConfig struct {
+	// Network is the network (e.g. 10.0.0.0/24) or range (e.g. 10.0.0.10-10.0.0.50) to allocate the address from.
+	Network string
+	// NetworkView is the network view of the network. The default network view is used if it is empty.
+	NetworkView string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordStatus contains the Infoblox specific status of a DNSRecord.
+type DNSRecordStatus struct {
+	metav1.TypeMeta
+
+	// AllocatedAddress is the address allocated by the grid if the DNSRecord uses next available IP allocation.
+	// It is kept across reconciliations until the DNSRecord is deleted.
+	AllocatedAddress string
+	// Zones are the view-qualified zones the record set has been written to, one per DNS view.
+	Zones []string
+	// PendingChanges are the changes of the record set queued for approval on the grid.
+	PendingChanges []PendingChange
+	// Changes are the replacements of records of the record set which have been started but not completed, one per
+	// zone. They are journaled before records are deleted or created, so that an interrupted replacement can be finished or undone.
+	Changes []RecordSetChange
+	// Records are the records written by the extension with the attributes last applied to them. Record sets are read
+	// by their references, and records with their references are regarded as written by the extension.
+	Records []ManagedRecord
+}
+
+// PendingChange is a change queued for approval on the grid.
+type PendingChange struct {
+	// Task is the reference of the scheduled task holding the change.
+	Task string
+	// Generation is the generation of the DNSRecord the change has been submitted for.
+	Generation int64
+	// ScheduledTime is the time the change is scheduled for. It is only set for scheduled changes.
+	ScheduledTime *metav1.Time
+}
+
+// ManagedRecord is a record written by the extension.
+type ManagedRecord struct {
+	// Zone is the view-qualified zone of the record.
+	Zone string
+	// Ref is the WAPI reference of the record.
+	Ref string
+	// View is the DNS view of the record.
+	View string
+	// Name is the name of the record.
+	Name string
+	// RecordType is the type of the record, ALIAS for alias records.
+	RecordType string
+	// Value is the value last applied to the record.
+	Value string
+	// TTL is the effective TTL of the record.
+	TTL int64
+}
+
+// RecordSetChange is a replacement of records of the record set in a zone.
+type RecordSetChange struct {
+	// Zone is the view-qualified zone of the record set.
+	Zone string
+	// RecordType is the record type of the record set, ALIAS for alias records.
+	RecordType string
+	// Previous are the records of the record set before the change.
+	Previous []JournaledRecord
+	// Values are the values of the record set written by the change.
+	Values []string
+	// TTL is the TTL of the records written by the change.
+	TTL int64
+	// TargetType is the target type of the alias records written by the change.
+	TargetType string
+}
+
+// JournaledRecord is a record of the record set before a change.
+type JournaledRecord struct {
+	// Ref is the WAPI reference of the record.
+	Ref string
+	// Value is the value of the record.
+	Value string
+	// TTL is the TTL of the record.
+	TTL int64
+	// TargetType is the target type of an alias record.
+	TargetType string
+}
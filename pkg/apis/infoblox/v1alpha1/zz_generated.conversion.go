@@ -0,0 +1,497 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright (c) SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	infoblox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AliasConfig)(nil), (*infoblox.AliasConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AliasConfig_To_infoblox_AliasConfig(a.(*AliasConfig), b.(*infoblox.AliasConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.AliasConfig)(nil), (*AliasConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_AliasConfig_To_v1alpha1_AliasConfig(a.(*infoblox.AliasConfig), b.(*AliasConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChangeScheduleConfig)(nil), (*infoblox.ChangeScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChangeScheduleConfig_To_infoblox_ChangeScheduleConfig(a.(*ChangeScheduleConfig), b.(*infoblox.ChangeScheduleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.ChangeScheduleConfig)(nil), (*ChangeScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_ChangeScheduleConfig_To_v1alpha1_ChangeScheduleConfig(a.(*infoblox.ChangeScheduleConfig), b.(*ChangeScheduleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*infoblox.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_infoblox_DNSRecordConfig(a.(*DNSRecordConfig), b.(*infoblox.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*infoblox.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordStatus)(nil), (*infoblox.DNSRecordStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordStatus_To_infoblox_DNSRecordStatus(a.(*DNSRecordStatus), b.(*infoblox.DNSRecordStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.DNSRecordStatus)(nil), (*DNSRecordStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(a.(*infoblox.DNSRecordStatus), b.(*DNSRecordStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HostConfig)(nil), (*infoblox.HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostConfig_To_infoblox_HostConfig(a.(*HostConfig), b.(*infoblox.HostConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.HostConfig)(nil), (*HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_HostConfig_To_v1alpha1_HostConfig(a.(*infoblox.HostConfig), b.(*HostConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HostDHCPConfig)(nil), (*infoblox.HostDHCPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostDHCPConfig_To_infoblox_HostDHCPConfig(a.(*HostDHCPConfig), b.(*infoblox.HostDHCPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.HostDHCPConfig)(nil), (*HostDHCPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_HostDHCPConfig_To_v1alpha1_HostDHCPConfig(a.(*infoblox.HostDHCPConfig), b.(*HostDHCPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JournaledRecord)(nil), (*infoblox.JournaledRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JournaledRecord_To_infoblox_JournaledRecord(a.(*JournaledRecord), b.(*infoblox.JournaledRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.JournaledRecord)(nil), (*JournaledRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_JournaledRecord_To_v1alpha1_JournaledRecord(a.(*infoblox.JournaledRecord), b.(*JournaledRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedRecord)(nil), (*infoblox.ManagedRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedRecord_To_infoblox_ManagedRecord(a.(*ManagedRecord), b.(*infoblox.ManagedRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.ManagedRecord)(nil), (*ManagedRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_ManagedRecord_To_v1alpha1_ManagedRecord(a.(*infoblox.ManagedRecord), b.(*ManagedRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NameServer)(nil), (*infoblox.NameServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NameServer_To_infoblox_NameServer(a.(*NameServer), b.(*infoblox.NameServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.NameServer)(nil), (*NameServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_NameServer_To_v1alpha1_NameServer(a.(*infoblox.NameServer), b.(*NameServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NextAvailableIPConfig)(nil), (*infoblox.NextAvailableIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NextAvailableIPConfig_To_infoblox_NextAvailableIPConfig(a.(*NextAvailableIPConfig), b.(*infoblox.NextAvailableIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.NextAvailableIPConfig)(nil), (*NextAvailableIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_NextAvailableIPConfig_To_v1alpha1_NextAvailableIPConfig(a.(*infoblox.NextAvailableIPConfig), b.(*NextAvailableIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PendingChange)(nil), (*infoblox.PendingChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PendingChange_To_infoblox_PendingChange(a.(*PendingChange), b.(*infoblox.PendingChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.PendingChange)(nil), (*PendingChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_PendingChange_To_v1alpha1_PendingChange(a.(*infoblox.PendingChange), b.(*PendingChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RecordSetChange)(nil), (*infoblox.RecordSetChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RecordSetChange_To_infoblox_RecordSetChange(a.(*RecordSetChange), b.(*infoblox.RecordSetChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.RecordSetChange)(nil), (*RecordSetChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_RecordSetChange_To_v1alpha1_RecordSetChange(a.(*infoblox.RecordSetChange), b.(*RecordSetChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SharedRecordConfig)(nil), (*infoblox.SharedRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedRecordConfig_To_infoblox_SharedRecordConfig(a.(*SharedRecordConfig), b.(*infoblox.SharedRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*infoblox.SharedRecordConfig)(nil), (*SharedRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_infoblox_SharedRecordConfig_To_v1alpha1_SharedRecordConfig(a.(*infoblox.SharedRecordConfig), b.(*SharedRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AliasConfig_To_infoblox_AliasConfig(in *AliasConfig, out *infoblox.AliasConfig, s conversion.Scope) error {
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_v1alpha1_AliasConfig_To_infoblox_AliasConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AliasConfig_To_infoblox_AliasConfig(in *AliasConfig, out *infoblox.AliasConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AliasConfig_To_infoblox_AliasConfig(in, out, s)
+}
+
+func autoConvert_infoblox_AliasConfig_To_v1alpha1_AliasConfig(in *infoblox.AliasConfig, out *AliasConfig, s conversion.Scope) error {
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_infoblox_AliasConfig_To_v1alpha1_AliasConfig is an autogenerated conversion function.
+func Convert_infoblox_AliasConfig_To_v1alpha1_AliasConfig(in *infoblox.AliasConfig, out *AliasConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_AliasConfig_To_v1alpha1_AliasConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ChangeScheduleConfig_To_infoblox_ChangeScheduleConfig(in *ChangeScheduleConfig, out *infoblox.ChangeScheduleConfig, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_ChangeScheduleConfig_To_infoblox_ChangeScheduleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ChangeScheduleConfig_To_infoblox_ChangeScheduleConfig(in *ChangeScheduleConfig, out *infoblox.ChangeScheduleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChangeScheduleConfig_To_infoblox_ChangeScheduleConfig(in, out, s)
+}
+
+func autoConvert_infoblox_ChangeScheduleConfig_To_v1alpha1_ChangeScheduleConfig(in *infoblox.ChangeScheduleConfig, out *ChangeScheduleConfig, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_infoblox_ChangeScheduleConfig_To_v1alpha1_ChangeScheduleConfig is an autogenerated conversion function.
+func Convert_infoblox_ChangeScheduleConfig_To_v1alpha1_ChangeScheduleConfig(in *infoblox.ChangeScheduleConfig, out *ChangeScheduleConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_ChangeScheduleConfig_To_v1alpha1_ChangeScheduleConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_infoblox_DNSRecordConfig(in *DNSRecordConfig, out *infoblox.DNSRecordConfig, s conversion.Scope) error {
+	out.Alias = (*infoblox.AliasConfig)(unsafe.Pointer(in.Alias))
+	out.Host = (*infoblox.HostConfig)(unsafe.Pointer(in.Host))
+	out.NextAvailableIP = (*infoblox.NextAvailableIPConfig)(unsafe.Pointer(in.NextAvailableIP))
+	out.Views = *(*[]string)(unsafe.Pointer(&in.Views))
+	out.SharedRecord = (*infoblox.SharedRecordConfig)(unsafe.Pointer(in.SharedRecord))
+	out.ChangeSchedule = (*infoblox.ChangeScheduleConfig)(unsafe.Pointer(in.ChangeSchedule))
+	out.SoftDelete = (*bool)(unsafe.Pointer(in.SoftDelete))
+	out.ConflictPolicy = in.ConflictPolicy
+	out.NameServers = *(*[]infoblox.NameServer)(unsafe.Pointer(&in.NameServers))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_infoblox_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_infoblox_DNSRecordConfig(in *DNSRecordConfig, out *infoblox.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_infoblox_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_infoblox_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *infoblox.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.Alias = (*AliasConfig)(unsafe.Pointer(in.Alias))
+	out.Host = (*HostConfig)(unsafe.Pointer(in.Host))
+	out.NextAvailableIP = (*NextAvailableIPConfig)(unsafe.Pointer(in.NextAvailableIP))
+	out.Views = *(*[]string)(unsafe.Pointer(&in.Views))
+	out.SharedRecord = (*SharedRecordConfig)(unsafe.Pointer(in.SharedRecord))
+	out.ChangeSchedule = (*ChangeScheduleConfig)(unsafe.Pointer(in.ChangeSchedule))
+	out.SoftDelete = (*bool)(unsafe.Pointer(in.SoftDelete))
+	out.ConflictPolicy = in.ConflictPolicy
+	out.NameServers = *(*[]NameServer)(unsafe.Pointer(&in.NameServers))
+	return nil
+}
+
+// Convert_infoblox_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_infoblox_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *infoblox.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordStatus_To_infoblox_DNSRecordStatus(in *DNSRecordStatus, out *infoblox.DNSRecordStatus, s conversion.Scope) error {
+	out.AllocatedAddress = in.AllocatedAddress
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.PendingChanges = *(*[]infoblox.PendingChange)(unsafe.Pointer(&in.PendingChanges))
+	out.Changes = *(*[]infoblox.RecordSetChange)(unsafe.Pointer(&in.Changes))
+	out.Records = *(*[]infoblox.ManagedRecord)(unsafe.Pointer(&in.Records))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordStatus_To_infoblox_DNSRecordStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordStatus_To_infoblox_DNSRecordStatus(in *DNSRecordStatus, out *infoblox.DNSRecordStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordStatus_To_infoblox_DNSRecordStatus(in, out, s)
+}
+
+func autoConvert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in *infoblox.DNSRecordStatus, out *DNSRecordStatus, s conversion.Scope) error {
+	out.AllocatedAddress = in.AllocatedAddress
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.PendingChanges = *(*[]PendingChange)(unsafe.Pointer(&in.PendingChanges))
+	out.Changes = *(*[]RecordSetChange)(unsafe.Pointer(&in.Changes))
+	out.Records = *(*[]ManagedRecord)(unsafe.Pointer(&in.Records))
+	return nil
+}
+
+// Convert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus is an autogenerated conversion function.
+func Convert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in *infoblox.DNSRecordStatus, out *DNSRecordStatus, s conversion.Scope) error {
+	return autoConvert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostConfig_To_infoblox_HostConfig(in *HostConfig, out *infoblox.HostConfig, s conversion.Scope) error {
+	out.NetworkView = in.NetworkView
+	out.DHCP = (*infoblox.HostDHCPConfig)(unsafe.Pointer(in.DHCP))
+	return nil
+}
+
+// Convert_v1alpha1_HostConfig_To_infoblox_HostConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HostConfig_To_infoblox_HostConfig(in *HostConfig, out *infoblox.HostConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostConfig_To_infoblox_HostConfig(in, out, s)
+}
+
+func autoConvert_infoblox_HostConfig_To_v1alpha1_HostConfig(in *infoblox.HostConfig, out *HostConfig, s conversion.Scope) error {
+	out.NetworkView = in.NetworkView
+	out.DHCP = (*HostDHCPConfig)(unsafe.Pointer(in.DHCP))
+	return nil
+}
+
+// Convert_infoblox_HostConfig_To_v1alpha1_HostConfig is an autogenerated conversion function.
+func Convert_infoblox_HostConfig_To_v1alpha1_HostConfig(in *infoblox.HostConfig, out *HostConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_HostConfig_To_v1alpha1_HostConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostDHCPConfig_To_infoblox_HostDHCPConfig(in *HostDHCPConfig, out *infoblox.HostDHCPConfig, s conversion.Scope) error {
+	out.MAC = in.MAC
+	out.DUID = in.DUID
+	return nil
+}
+
+// Convert_v1alpha1_HostDHCPConfig_To_infoblox_HostDHCPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HostDHCPConfig_To_infoblox_HostDHCPConfig(in *HostDHCPConfig, out *infoblox.HostDHCPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostDHCPConfig_To_infoblox_HostDHCPConfig(in, out, s)
+}
+
+func autoConvert_infoblox_HostDHCPConfig_To_v1alpha1_HostDHCPConfig(in *infoblox.HostDHCPConfig, out *HostDHCPConfig, s conversion.Scope) error {
+	out.MAC = in.MAC
+	out.DUID = in.DUID
+	return nil
+}
+
+// Convert_infoblox_HostDHCPConfig_To_v1alpha1_HostDHCPConfig is an autogenerated conversion function.
+func Convert_infoblox_HostDHCPConfig_To_v1alpha1_HostDHCPConfig(in *infoblox.HostDHCPConfig, out *HostDHCPConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_HostDHCPConfig_To_v1alpha1_HostDHCPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_JournaledRecord_To_infoblox_JournaledRecord(in *JournaledRecord, out *infoblox.JournaledRecord, s conversion.Scope) error {
+	out.Ref = in.Ref
+	out.Value = in.Value
+	out.TTL = in.TTL
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_v1alpha1_JournaledRecord_To_infoblox_JournaledRecord is an autogenerated conversion function.
+func Convert_v1alpha1_JournaledRecord_To_infoblox_JournaledRecord(in *JournaledRecord, out *infoblox.JournaledRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JournaledRecord_To_infoblox_JournaledRecord(in, out, s)
+}
+
+func autoConvert_infoblox_JournaledRecord_To_v1alpha1_JournaledRecord(in *infoblox.JournaledRecord, out *JournaledRecord, s conversion.Scope) error {
+	out.Ref = in.Ref
+	out.Value = in.Value
+	out.TTL = in.TTL
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_infoblox_JournaledRecord_To_v1alpha1_JournaledRecord is an autogenerated conversion function.
+func Convert_infoblox_JournaledRecord_To_v1alpha1_JournaledRecord(in *infoblox.JournaledRecord, out *JournaledRecord, s conversion.Scope) error {
+	return autoConvert_infoblox_JournaledRecord_To_v1alpha1_JournaledRecord(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagedRecord_To_infoblox_ManagedRecord(in *ManagedRecord, out *infoblox.ManagedRecord, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Ref = in.Ref
+	out.View = in.View
+	out.Name = in.Name
+	out.RecordType = in.RecordType
+	out.Value = in.Value
+	out.TTL = in.TTL
+	return nil
+}
+
+// Convert_v1alpha1_ManagedRecord_To_infoblox_ManagedRecord is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedRecord_To_infoblox_ManagedRecord(in *ManagedRecord, out *infoblox.ManagedRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedRecord_To_infoblox_ManagedRecord(in, out, s)
+}
+
+func autoConvert_infoblox_ManagedRecord_To_v1alpha1_ManagedRecord(in *infoblox.ManagedRecord, out *ManagedRecord, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Ref = in.Ref
+	out.View = in.View
+	out.Name = in.Name
+	out.RecordType = in.RecordType
+	out.Value = in.Value
+	out.TTL = in.TTL
+	return nil
+}
+
+// Convert_infoblox_ManagedRecord_To_v1alpha1_ManagedRecord is an autogenerated conversion function.
+func Convert_infoblox_ManagedRecord_To_v1alpha1_ManagedRecord(in *infoblox.ManagedRecord, out *ManagedRecord, s conversion.Scope) error {
+	return autoConvert_infoblox_ManagedRecord_To_v1alpha1_ManagedRecord(in, out, s)
+}
+
+func autoConvert_v1alpha1_NameServer_To_infoblox_NameServer(in *NameServer, out *infoblox.NameServer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1alpha1_NameServer_To_infoblox_NameServer is an autogenerated conversion function.
+func Convert_v1alpha1_NameServer_To_infoblox_NameServer(in *NameServer, out *infoblox.NameServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NameServer_To_infoblox_NameServer(in, out, s)
+}
+
+func autoConvert_infoblox_NameServer_To_v1alpha1_NameServer(in *infoblox.NameServer, out *NameServer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_infoblox_NameServer_To_v1alpha1_NameServer is an autogenerated conversion function.
+func Convert_infoblox_NameServer_To_v1alpha1_NameServer(in *infoblox.NameServer, out *NameServer, s conversion.Scope) error {
+	return autoConvert_infoblox_NameServer_To_v1alpha1_NameServer(in, out, s)
+}
+
+func autoConvert_v1alpha1_NextAvailableIPConfig_To_infoblox_NextAvailableIPConfig(in *NextAvailableIPConfig, out *infoblox.NextAvailableIPConfig, s conversion.Scope) error {
+	out.Network = in.Network
+	out.NetworkView = in.NetworkView
+	return nil
+}
+
+// Convert_v1alpha1_NextAvailableIPConfig_To_infoblox_NextAvailableIPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NextAvailableIPConfig_To_infoblox_NextAvailableIPConfig(in *NextAvailableIPConfig, out *infoblox.NextAvailableIPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NextAvailableIPConfig_To_infoblox_NextAvailableIPConfig(in, out, s)
+}
+
+func autoConvert_infoblox_NextAvailableIPConfig_To_v1alpha1_NextAvailableIPConfig(in *infoblox.NextAvailableIPConfig, out *NextAvailableIPConfig, s conversion.Scope) error {
+	out.Network = in.Network
+	out.NetworkView = in.NetworkView
+	return nil
+}
+
+// Convert_infoblox_NextAvailableIPConfig_To_v1alpha1_NextAvailableIPConfig is an autogenerated conversion function.
+func Convert_infoblox_NextAvailableIPConfig_To_v1alpha1_NextAvailableIPConfig(in *infoblox.NextAvailableIPConfig, out *NextAvailableIPConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_NextAvailableIPConfig_To_v1alpha1_NextAvailableIPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PendingChange_To_infoblox_PendingChange(in *PendingChange, out *infoblox.PendingChange, s conversion.Scope) error {
+	out.Task = in.Task
+	out.Generation = in.Generation
+	out.ScheduledTime = (*v1.Time)(unsafe.Pointer(in.ScheduledTime))
+	return nil
+}
+
+// Convert_v1alpha1_PendingChange_To_infoblox_PendingChange is an autogenerated conversion function.
+func Convert_v1alpha1_PendingChange_To_infoblox_PendingChange(in *PendingChange, out *infoblox.PendingChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PendingChange_To_infoblox_PendingChange(in, out, s)
+}
+
+func autoConvert_infoblox_PendingChange_To_v1alpha1_PendingChange(in *infoblox.PendingChange, out *PendingChange, s conversion.Scope) error {
+	out.Task = in.Task
+	out.Generation = in.Generation
+	out.ScheduledTime = (*v1.Time)(unsafe.Pointer(in.ScheduledTime))
+	return nil
+}
+
+// Convert_infoblox_PendingChange_To_v1alpha1_PendingChange is an autogenerated conversion function.
+func Convert_infoblox_PendingChange_To_v1alpha1_PendingChange(in *infoblox.PendingChange, out *PendingChange, s conversion.Scope) error {
+	return autoConvert_infoblox_PendingChange_To_v1alpha1_PendingChange(in, out, s)
+}
+
+func autoConvert_v1alpha1_RecordSetChange_To_infoblox_RecordSetChange(in *RecordSetChange, out *infoblox.RecordSetChange, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.RecordType = in.RecordType
+	out.Previous = *(*[]infoblox.JournaledRecord)(unsafe.Pointer(&in.Previous))
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.TTL = in.TTL
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_v1alpha1_RecordSetChange_To_infoblox_RecordSetChange is an autogenerated conversion function.
+func Convert_v1alpha1_RecordSetChange_To_infoblox_RecordSetChange(in *RecordSetChange, out *infoblox.RecordSetChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RecordSetChange_To_infoblox_RecordSetChange(in, out, s)
+}
+
+func autoConvert_infoblox_RecordSetChange_To_v1alpha1_RecordSetChange(in *infoblox.RecordSetChange, out *RecordSetChange, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.RecordType = in.RecordType
+	out.Previous = *(*[]JournaledRecord)(unsafe.Pointer(&in.Previous))
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.TTL = in.TTL
+	out.TargetType = in.TargetType
+	return nil
+}
+
+// Convert_infoblox_RecordSetChange_To_v1alpha1_RecordSetChange is an autogenerated conversion function.
+func Convert_infoblox_RecordSetChange_To_v1alpha1_RecordSetChange(in *infoblox.RecordSetChange, out *RecordSetChange, s conversion.Scope) error {
+	return autoConvert_infoblox_RecordSetChange_To_v1alpha1_RecordSetChange(in, out, s)
+}
+
+func autoConvert_v1alpha1_SharedRecordConfig_To_infoblox_SharedRecordConfig(in *SharedRecordConfig, out *infoblox.SharedRecordConfig, s conversion.Scope) error {
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_v1alpha1_SharedRecordConfig_To_infoblox_SharedRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SharedRecordConfig_To_infoblox_SharedRecordConfig(in *SharedRecordConfig, out *infoblox.SharedRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedRecordConfig_To_infoblox_SharedRecordConfig(in, out, s)
+}
+
+func autoConvert_infoblox_SharedRecordConfig_To_v1alpha1_SharedRecordConfig(in *infoblox.SharedRecordConfig, out *SharedRecordConfig, s conversion.Scope) error {
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_infoblox_SharedRecordConfig_To_v1alpha1_SharedRecordConfig is an autogenerated conversion function.
+func Convert_infoblox_SharedRecordConfig_To_v1alpha1_SharedRecordConfig(in *infoblox.SharedRecordConfig, out *SharedRecordConfig, s conversion.Scope) error {
+	return autoConvert_infoblox_SharedRecordConfig_To_v1alpha1_SharedRecordConfig(in, out, s)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// ValidateDNSRecordConfig validates the provider config of a DNSRecord of the given record type.
+func ValidateDNSRecordConfig(config *infoblox.DNSRecordConfig, recordType extensionsv1alpha1.DNSRecordType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Alias != nil {
+		aliasPath := fldPath.Child("alias")
+		if recordType != extensionsv1alpha1.DNSRecordTypeCNAME {
+			allErrs = append(allErrs, field.Forbidden(aliasPath, "alias records are only supported for DNSRecords of type CNAME"))
+		}
+		if !utils.ValueExists(config.Alias.TargetType, raw.AliasTargetTypes) {
+			allErrs = append(allErrs, field.NotSupported(aliasPath.Child("targetType"), config.Alias.TargetType, raw.AliasTargetTypes))
+		}
+	}
+
+	nameServersPath := fldPath.Child("nameServers")
+	if len(config.NameServers) > 0 && string(recordType) != raw.Type_NS {
+		allErrs = append(allErrs, field.Forbidden(nameServersPath, "name servers are only supported for DNSRecords of type NS"))
+	}
+	for i, ns := range config.NameServers {
+		idxPath := nameServersPath.Index(i)
+		if ns.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name of the name server is required"))
+		}
+		if len(ns.Addresses) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("addresses"), "at least one address is required"))
+		}
+		for j, address := range ns.Addresses {
+			if net.ParseIP(address) == nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("addresses").Index(j), address, "must be a valid IPv4 or IPv6 address"))
+			}
+		}
+	}
+
+	addressRecord := string(recordType) == raw.Type_A || string(recordType) == raw.Type_AAAA
+	if config.Host != nil && !addressRecord {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("host"), "host records are only supported for DNSRecords of type A and AAAA"))
+	}
+	if config.NextAvailableIP != nil && !addressRecord {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nextAvailableIP"), "next available IP allocation is only supported for DNSRecords of type A and AAAA"))
+	}
+
+	if config.SharedRecord != nil {
+		sharedRecordPath := fldPath.Child("sharedRecord")
+		if !utils.ValueExists(string(recordType), raw.SharedRecordTypes) {
+			allErrs = append(allErrs, field.Forbidden(sharedRecordPath, fmt.Sprintf("shared records are only supported for DNSRecords of type %v", raw.SharedRecordTypes)))
+		}
+		if config.Alias != nil || config.Host != nil || config.NextAvailableIP != nil {
+			allErrs = append(allErrs, field.Forbidden(sharedRecordPath, "shared records cannot be combined with alias, host records, or next available IP allocation"))
+		}
+	}
+
+	return allErrs
+}
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
 	configloader "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config/loader"
@@ -70,6 +71,48 @@ func (c *Config) Apply(cfg *config.ControllerConfiguration) {
 	*cfg = *c.Config
 }
 
+// ApplyZoneCacheTTL sets the given zone cache TTL to that of this Config.
+func (c *Config) ApplyZoneCacheTTL(ttl *time.Duration) {
+	if c.Config.ZoneCacheTTL != nil {
+		*ttl = c.Config.ZoneCacheTTL.Duration
+	}
+}
+
+// ApplyZoneCreation sets the given zone creation configuration to that of this Config.
+func (c *Config) ApplyZoneCreation(zoneCreation **config.ZoneCreationConfiguration) {
+	*zoneCreation = c.Config.ZoneCreation
+}
+
+// ApplyChangeSchedule sets the given change schedule to that of this Config.
+func (c *Config) ApplyChangeSchedule(changeSchedule **config.ChangeScheduleConfiguration) {
+	*changeSchedule = c.Config.ChangeSchedule
+}
+
+// ApplyServiceRestart sets the given service restart configuration to that of this Config.
+func (c *Config) ApplyServiceRestart(serviceRestart **config.ServiceRestartConfiguration) {
+	*serviceRestart = c.Config.ServiceRestart
+}
+
+// ApplySoftDelete sets the given soft deletion configuration to that of this Config.
+func (c *Config) ApplySoftDelete(softDelete **config.SoftDeleteConfiguration) {
+	*softDelete = c.Config.SoftDelete
+}
+
+// ApplyDeletionBudget sets the given deletion budget configuration to that of this Config.
+func (c *Config) ApplyDeletionBudget(deletionBudget **config.DeletionBudgetConfiguration) {
+	*deletionBudget = c.Config.DeletionBudget
+}
+
+// ApplyDriftDetection sets the given drift detection configuration to that of this Config.
+func (c *Config) ApplyDriftDetection(driftDetection **config.DriftDetectionConfiguration) {
+	*driftDetection = c.Config.DriftDetection
+}
+
+// ApplyConflicts sets the given conflict configuration to that of this Config.
+func (c *Config) ApplyConflicts(conflicts **config.ConflictConfiguration) {
+	*conflicts = c.Config.Conflicts
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
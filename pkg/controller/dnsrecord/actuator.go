@@ -17,19 +17,33 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/helper"
+	infobloxv1alpha1 "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/v1alpha1"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/validation"
 	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/common"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -39,91 +53,573 @@ const (
 	// in order to prevent quick retries that could quickly exhaust the account rate limits in case of e.g.
 	// configuration issues.
 	requeueAfterOnProviderError = 30 * time.Second
+
+	// requeueAfterOnZoneLocked is the value for RequeueAfter to be returned if a zone is locked or disabled.
+	requeueAfterOnZoneLocked = 5 * time.Minute
+
+	// defaultDeletionBudgetWindow is the default time window of the deletion budget per zone.
+	defaultDeletionBudgetWindow = time.Hour
+
+	// ConditionTypeZoneLocked is the type of the DNSRecord condition reporting locked or disabled zones.
+	ConditionTypeZoneLocked gardencorev1beta1.ConditionType = "ZoneLocked"
+
+	// recordModeHost is the value of the recordMode secret key selecting host records for A and AAAA record sets.
+	recordModeHost = "host"
 )
 
 type actuator struct {
 	common.ClientContext
-	logger logr.Logger
+	logger         logr.Logger
+	zoneCreation   *config.ZoneCreationConfiguration
+	changeSchedule *config.ChangeScheduleConfiguration
+	softDelete     *config.SoftDeleteConfiguration
+	deletionBudget *dnsclient.DeletionBudget
+	conflicts      *config.ConflictConfiguration
+	recorder       record.EventRecorder
+	purger         *softDeletePurger
 }
 
-// NewActuator creates a new dnsrecord.Actuator.
-func NewActuator(logger logr.Logger) dnsrecord.Actuator {
-	return &actuator{
-		logger: logger.WithName("infoblox-dnsrecord-actuator"),
+// NewActuator creates a new dnsrecord.Actuator. Missing zones are only created if zoneCreation is not nil.
+// Changes are restricted to the maintenance window of changeSchedule if it is not nil.
+// Records are soft-deleted according to softDelete and the providerConfig of the DNSRecord.
+// Deletions of records are limited by deletionBudget if it is not nil.
+// Records not written by the extension are handled according to conflicts and the providerConfig of the DNSRecord.
+func NewActuator(logger logr.Logger, recorder record.EventRecorder, zoneCreation *config.ZoneCreationConfiguration,
+	changeSchedule *config.ChangeScheduleConfiguration, softDelete *config.SoftDeleteConfiguration, deletionBudget *config.DeletionBudgetConfiguration,
+	conflicts *config.ConflictConfiguration) dnsrecord.Actuator {
+	a := &actuator{
+		logger:         logger.WithName("infoblox-dnsrecord-actuator"),
+		zoneCreation:   zoneCreation,
+		changeSchedule: changeSchedule,
+		softDelete:     softDelete,
+		deletionBudget: newDeletionBudget(deletionBudget),
+		conflicts:      conflicts,
+		recorder:       recorder,
 	}
+	a.purger = newSoftDeletePurger(a)
+	return a
 }
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	config, err := helper.DNSRecordConfigFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return err
+	}
+	if errs := validation.ValidateDNSRecordConfig(config, dns.Spec.RecordType, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid provider config: %w", errs.ToAggregate())
+	}
+	window, err := a.getChangeWindow(config)
+	if err != nil {
+		return err
+	}
+	if window != nil && config.NextAvailableIP != nil {
+		return fmt.Errorf("next available IP allocation cannot be combined with a change schedule")
+	}
+	status, err := helper.DNSRecordStatusFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return err
+	}
+	hostOptions, err := a.getHostOptions(ctx, dns, config)
+	if err != nil {
+		return err
+	}
+	conflictPolicy, ownerAttribute, err := a.getConflictPolicy(config)
+	if err != nil {
+		return err
+	}
 
-	dnsClient, err := dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), dns.Spec.SecretRef)
+	// Submit changes outside of the maintenance window as scheduled tasks
+	scheduledAt := dnsclient.NextChangeTime(window, time.Now())
+	opts := a.clientOptions(dns, config, status, scheduledAt)
+	if conflictPolicy != "" {
+		opts.OwnerAttribute = ownerAttribute
+	}
+	opts.Journal = a.journalChanges(dns, status)
+	dnsClient, err := dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), dns.Spec.SecretRef, opts)
 	if err != nil {
 		return err
 	}
+	// Restart the DNS services of the grid if changes need it, also after partial failures
+	defer dnsClient.RestartServicesIfNeeded()
+	// Replacements of records interrupted by a previous reconciliation are finished by writing the record set again
+	if len(status.Changes) > 0 {
+		a.logger.Info("Finishing interrupted DNS recordset changes", "managedZones", changeZones(status.Changes), "name", dns.Spec.Name, "dnsrecord", kutil.ObjectName(dns))
+	}
 
-	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, dns, dnsClient)
+	views, err := a.getViews(ctx, dns, config)
 	if err != nil {
 		return err
 	}
+	knownZones := getKnownZones(dns, status)
+	if config.NextAvailableIP == nil {
+		status.AllocatedAddress = ""
+	}
 
-	// Create or update DNS recordset
-	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	a.logger.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", kutil.ObjectName(dns))
-	secret, err := extensionscontroller.GetSecretByReference(ctx, a.Client(), &dns.Spec.SecretRef)
+	// Do not submit further changes while earlier ones are awaiting approval or scheduled
+	if err := a.checkPendingChanges(ctx, dns, dnsClient, status); err != nil {
+		return err
+	}
+
+	// Write the record set into every view. A failure in one view does not prevent the others from being updated.
+	result, err := a.reconcileViews(ctx, dns, dnsClient, config, status, hostOptions, views, knownZones)
 	if err != nil {
 		return err
 	}
+	a.deleteUnconfiguredViews(ctx, dns, dnsClient, config, status, hostOptions, views, knownZones, result)
+	addScheduledChanges(dns, status, dnsClient.ScheduledTasks(), scheduledAt)
 
-	view, ok := secret.Data["view"]
-	if !ok {
-		return fmt.Errorf("no view found")
+	if err := a.updateStatus(ctx, dns, dnsClient, status, views, result); err != nil {
+		return err
+	}
+	if len(result.errs) == 0 {
+		if err := a.removeDeletionApproval(ctx, dns); err != nil {
+			return err
+		}
 	}
-	if err := dnsClient.CreateOrUpdateRecordSet(ctx, string(view), managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+	return reconcileError(views, status, result)
+}
+
+// reconcileResult collects the outcome of writing the record set into the DNS views.
+type reconcileResult struct {
+	// zones are the zones containing the record set, including zones it could not be updated in.
+	zones []string
+	// written are the zones the record set has been written to.
+	written []string
+	// errs are the errors of the views the record set could not be written to.
+	errs []error
+	// lockErrs are the errors of locked or disabled zones.
+	lockErrs []error
+}
+
+// reconcileViews writes the record set into the given views. If there is only a single view, an error writing to it
+// is returned directly, otherwise errors are collected in the result.
+func (a *actuator) reconcileViews(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, config *infoblox.DNSRecordConfig,
+	status *infoblox.DNSRecordStatus, hostOptions *dnsclient.HostOptions, views, knownZones []string) (*reconcileResult, error) {
+	result := &reconcileResult{}
+	// allocationPending is true once the allocation of the address awaits approval. The other views are written once
+	// the address is known.
+	var allocationPending bool
+	for _, view := range views {
+		viewZones := zonesInView(knownZones, view, len(views) == 1)
+		if allocationPending {
+			result.zones = append(result.zones, viewZones...)
+			continue
+		}
+		managedZone, err := a.reconcileView(ctx, dns, dnsClient, config, status, hostOptions, view, viewZones)
+		changed := zonesInView(changeZones(status.Changes), view, len(views) == 1)
+		if err != nil {
+			// Restore the records replaced by interrupted or failed changes, unless the change awaits approval
+			if _, ok := pendingApprovalTask(err); !ok && len(changed) > 0 {
+				a.undoChanges(ctx, dns, dnsClient, status, changed)
+			}
+			if deletionBlocked(err) {
+				a.reportBlockedDeletion(dns, err)
+			}
+			if task, ok := pendingApprovalTask(err); ok {
+				a.logger.Info("DNS recordset change is awaiting approval", "task", task, "view", view, "name", dns.Spec.Name, "dnsrecord", kutil.ObjectName(dns))
+				addPendingChange(dns, status, task)
+				result.zones = append(result.zones, viewZones...)
+				allocationPending = config.NextAvailableIP != nil && status.AllocatedAddress == ""
+				continue
+			}
+			if dnsclient.IsZoneLocked(err) {
+				result.lockErrs = append(result.lockErrs, err)
+			} else if len(views) == 1 {
+				if err := a.storeManagedRecords(ctx, dns, dnsClient, status); err != nil {
+					a.logger.Error(err, "Could not store managed DNS records", "dnsrecord", kutil.ObjectName(dns))
+				}
+				return nil, err
+			}
+			result.errs = append(result.errs, viewError(view, views, err))
+			// Keep the zone written by a previous reconciliation, so that the record set is still deleted with the DNSRecord
+			result.zones = append(result.zones, viewZones...)
+			continue
+		}
+		result.zones = append(result.zones, managedZone.String())
+		result.written = append(result.written, managedZone.String())
+		status.Changes = removeChanges(status.Changes, changed)
+	}
+	return result, nil
+}
+
+// deleteUnconfiguredViews deletes the record set from the known zones of views which are no longer configured.
+// Zones the record set could not be deleted from are kept in the result.
+func (a *actuator) deleteUnconfiguredViews(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, config *infoblox.DNSRecordConfig,
+	status *infoblox.DNSRecordStatus, hostOptions *dnsclient.HostOptions, views, knownZones []string, result *reconcileResult) {
+	for _, zone := range knownZones {
+		id, err := dnsclient.ParseZoneID(zone)
+		if err != nil || id.View == "" || utils.ValueExists(id.View, views) {
+			continue
+		}
+		if err := a.deleteView(ctx, dns, dnsClient, config, hostOptions, id.View, []string{zone}); err != nil {
+			if deletionBlocked(err) {
+				a.reportBlockedDeletion(dns, err)
+			}
+			if task, ok := pendingApprovalTask(err); ok {
+				addPendingChange(dns, status, task)
+				result.zones = append(result.zones, zone)
+				continue
+			}
+			if dnsclient.IsZoneLocked(err) {
+				result.lockErrs = append(result.lockErrs, err)
+			}
+			result.errs = append(result.errs, fmt.Errorf("view %s: %w", id.View, err))
+			result.zones = append(result.zones, zone)
+			continue
+		}
+		status.Changes = removeChanges(status.Changes, []string{zone})
+	}
+}
+
+// updateStatus stores the zones and records written by the reconciliation in the status of the DNSRecord, and updates
+// its conditions.
+func (a *actuator) updateStatus(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, status *infoblox.DNSRecordStatus,
+	views []string, result *reconcileResult) error {
+	if len(views) > 1 || len(result.zones) > 1 {
+		status.Zones = result.zones
+	} else {
+		status.Zones = nil
+	}
+	status.Records = fromManagedRecords(dnsClient.ManagedRecords())
+	return a.patchStatus(ctx, dns, status, func() {
+		if len(result.zones) > 0 {
+			dns.Status.Zone = &result.zones[0]
+		}
+		setZoneLockedCondition(dns, result.lockErrs)
+		setAwaitingApprovalCondition(dns, unscheduledTasks(status.PendingChanges), nil)
+		setChangesScheduledCondition(dns, scheduledChanges(status.PendingChanges))
+		// The record set has been written again, so that drift detected before is repaired
+		if len(result.errs) == 0 && len(status.PendingChanges) == 0 {
+			setDriftDetectedCondition(dns, nil)
+		}
+	})
+}
+
+// reconcileError returns the error of the reconciliation. Pending changes are reported until they have been applied,
+// and failed views are retried later.
+func reconcileError(views []string, status *infoblox.DNSRecordStatus, result *reconcileResult) error {
+	switch {
+	case len(result.errs) == 0 && len(status.PendingChanges) > 0:
+		return pendingChangesError(status.PendingChanges)
+	case len(result.errs) == 0:
+		return nil
+	case len(views) == 1 && len(result.errs) == 1:
 		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
-			RequeueAfter: requeueAfterOnProviderError,
+			Cause:        result.errs[0],
+			RequeueAfter: requeueAfter(result.lockErrs),
+		}
+	case len(result.written) == 0:
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not write DNS recordset to any of the DNS views %v: %+v", views, utilerrors.NewAggregate(result.errs)),
+			RequeueAfter: requeueAfter(result.lockErrs),
+		}
+	default:
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("DNS recordset could not be written to all DNS views, it is only up to date in zones %v: %+v", result.written, utilerrors.NewAggregate(result.errs)),
+			RequeueAfter: requeueAfter(result.lockErrs),
+		}
+	}
+}
+
+// reconcileView writes the record set into the given view and returns the managed zone it has been written to.
+// An address allocated for the record set is stored in the given status and reused for the other views.
+func (a *actuator) reconcileView(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, config *infoblox.DNSRecordConfig,
+	status *infoblox.DNSRecordStatus, hostOptions *dnsclient.HostOptions, view string, knownZones []string) (dnsclient.ZoneID, error) {
+	// Determine DNS managed zone, and create it if allowed
+	managedZone, err := a.getManagedZone(ctx, dns, dnsClient, view, knownZones)
+	if err != nil && dnsclient.IsZoneNotFound(err) && a.zoneCreation != nil && (dns.Spec.Zone == nil || *dns.Spec.Zone == "") {
+		managedZone, err = a.createZone(ctx, dns, dnsClient, view)
+	}
+	if err != nil {
+		return dnsclient.ZoneID{}, err
+	}
+	if err := a.checkZoneWritable(ctx, dnsClient, managedZone); err != nil {
+		return dnsclient.ZoneID{}, err
+	}
+	if err := a.resolveConflicts(ctx, dns, dnsClient, config, hostOptions, managedZone, knownZones); err != nil {
+		return dnsclient.ZoneID{}, err
+	}
+
+	// Allocate the address if requested. Once allocated, it replaces the DNSRecord values until the DNSRecord is deleted.
+	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
+	values := dns.Spec.Values
+	if config.NextAvailableIP != nil {
+		if status.AllocatedAddress == "" {
+			alloc := dnsclient.AllocationOptions{Network: config.NextAvailableIP.Network, NetworkView: config.NextAvailableIP.NetworkView}
+			address, err := dnsClient.AllocateAddress(ctx, managedZone.View, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), ttl, alloc, hostOptions)
+			if err != nil {
+				return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("could not allocate address from %s for name %s in managed zone %s: %w", alloc.Network, dns.Spec.Name, managedZone, err),
+					RequeueAfter: requeueAfterOnProviderError,
+				}
+			}
+			a.logger.Info("Allocated address", "address", address, "network", alloc.Network, "name", dns.Spec.Name, "dnsrecord", kutil.ObjectName(dns))
+			status.AllocatedAddress = address
+		}
+		values = []string{status.AllocatedAddress}
+	}
+
+	// Create or update DNS recordset
+	a.logger.Info("Creating or updating DNS recordset", "managedZone", managedZone.String(), "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", values, "dnsrecord", kutil.ObjectName(dns))
+	switch {
+	case config.Alias != nil:
+		// Delete CNAME records written before the switch to alias records first, as they cannot coexist with them
+		if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, raw.Type_CNAME); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS recordset replaced by alias records in managed zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, raw.Type_CNAME, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		if err := dnsClient.CreateOrUpdateAliasRecordSet(ctx, managedZone.View, managedZone, dns.Spec.Name, config.Alias.TargetType, dns.Spec.Values, ttl); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS alias recordset in managed zone %s with name %s, target type %s, and targets %v: %w", managedZone, dns.Spec.Name, config.Alias.TargetType, dns.Spec.Values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	case string(dns.Spec.RecordType) == raw.Type_NS:
+		if err := dnsClient.CreateOrUpdateNSRecordSet(ctx, managedZone.View, managedZone, dns.Spec.Name, dns.Spec.Values, nameServerGlue(config)); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS NS recordset in managed zone %s with name %s and name servers %v: %w", managedZone, dns.Spec.Name, dns.Spec.Values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	case config.SharedRecord != nil:
+		if err := dnsClient.CreateOrUpdateSharedRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), values, ttl, config.SharedRecord.Group); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS shared recordset for zone %s with name %s, type %s, and rrdatas %v: %w", managedZone, dns.Spec.Name, dns.Spec.RecordType, values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		// Delete regular records written before the switch to shared records, as they would shadow them
+		if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType)); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS recordset replaced by shared records in managed zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	case hostOptions != nil:
+		if err := dnsClient.CreateOrUpdateHostRecordSet(ctx, managedZone.View, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), values, ttl, *hostOptions); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS host record in managed zone %s with name %s, type %s, and addresses %v: %w", managedZone, dns.Spec.Name, dns.Spec.RecordType, values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		// Delete plain records written before the switch to host records
+		if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType)); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS recordset replaced by host record in managed zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	default:
+		// Delete alias records written before the switch to CNAME records first, as they cannot coexist with them
+		if dns.Spec.RecordType == extensionsv1alpha1.DNSRecordTypeCNAME {
+			if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, raw.Type_ALIAS); err != nil {
+				return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("could not delete DNS alias recordset replaced by CNAME records in managed zone %s with name %s: %w", managedZone, dns.Spec.Name, err),
+					RequeueAfter: requeueAfterOnProviderError,
+				}
+			}
+		}
+		if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone.View, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), values, ttl); err != nil {
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %w", managedZone, dns.Spec.Name, dns.Spec.RecordType, values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
 		}
 	}
 
 	// Delete meta DNS recordset if exists
 	if dns.Status.LastOperation == nil || dns.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate {
 		name, recordType := dnsrecord.GetMetaRecordName(dns.Spec.Name), "TXT"
-		a.logger.Info("Deleting meta DNS recordset", "managedZone", managedZone, "name", name, "type", recordType, "dnsrecord", kutil.ObjectName(dns))
+		a.logger.Info("Deleting meta DNS recordset", "managedZone", managedZone.String(), "name", name, "type", recordType, "dnsrecord", kutil.ObjectName(dns))
 		if err := dnsClient.DeleteRecordSet(ctx, managedZone, name, recordType); err != nil {
-			return &reconcilerutils.RequeueAfterError{
-				Cause:        fmt.Errorf("could not delete meta DNS recordset in managed zone %s with name %s and type %s: %+v", managedZone, name, recordType, err),
+			return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete meta DNS recordset in managed zone %s with name %s and type %s: %w", managedZone, name, recordType, err),
 				RequeueAfter: requeueAfterOnProviderError,
 			}
 		}
 	}
 
-	// Update resource status
-	patch := client.MergeFrom(dns.DeepCopy())
-	dns.Status.Zone = &managedZone
-	return a.Client().Status().Patch(ctx, dns, patch)
+	a.purgeSoftDeletedRecords(ctx, dns, dnsClient, managedZone)
+	return managedZone, nil
+}
+
+// purgeSoftDeletedRecords purges the records soft-deleted in the given zone longer than the retention ago.
+func (a *actuator) purgeSoftDeletedRecords(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, managedZone dnsclient.ZoneID) {
+	if purged, err := dnsClient.PurgeSoftDeletedRecords(ctx, managedZone); err != nil {
+		a.logger.Error(err, "Could not purge soft-deleted records", "managedZone", managedZone.String(), "dnsrecord", kutil.ObjectName(dns))
+	} else if purged > 0 {
+		a.logger.Info("Purged soft-deleted records", "managedZone", managedZone.String(), "count", purged, "dnsrecord", kutil.ObjectName(dns))
+	}
 }
 
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
-	// Create GCP DNS client
-	dnsClient, err := dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), dns.Spec.SecretRef)
+	config, err := helper.DNSRecordConfigFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return err
+	}
+	status, err := helper.DNSRecordStatusFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return err
+	}
+
+	window, err := a.getChangeWindow(config)
+	if err != nil {
+		return err
+	}
+	// Submit changes outside of the maintenance window as scheduled tasks
+	scheduledAt := dnsclient.NextChangeTime(window, time.Now())
+	dnsClient, err := dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), dns.Spec.SecretRef, a.clientOptions(dns, config, status, scheduledAt))
+	if err != nil {
+		return err
+	}
+	// Restart the DNS services of the grid if changes need it, also after partial failures
+	defer dnsClient.RestartServicesIfNeeded()
+
+	views, err := a.getViews(ctx, dns, config)
+	if err != nil {
+		return err
+	}
+	knownZones := getKnownZones(dns, status)
+	// The record set is also deleted from views it has been written to before they were removed from the configuration
+	for _, zone := range knownZones {
+		if id, err := dnsclient.ParseZoneID(zone); err == nil && id.View != "" && !utils.ValueExists(id.View, views) {
+			views = append(views, id.View)
+		}
+	}
+
+	hostOptions, err := a.getHostOptions(ctx, dns, config)
 	if err != nil {
 		return err
 	}
 
+	// Do not submit further changes while earlier ones are awaiting approval or scheduled
+	if err := a.checkPendingChanges(ctx, dns, dnsClient, status); err != nil {
+		return err
+	}
+
+	var errs, lockErrs []error
+	for _, view := range views {
+		zones := zonesInView(knownZones, view, len(views) == 1)
+		if err := a.deleteView(ctx, dns, dnsClient, config, hostOptions, view, zones); err != nil {
+			if deletionBlocked(err) {
+				a.reportBlockedDeletion(dns, err)
+			}
+			if task, ok := pendingApprovalTask(err); ok {
+				a.logger.Info("DNS recordset deletion is awaiting approval", "task", task, "view", view, "name", dns.Spec.Name, "dnsrecord", kutil.ObjectName(dns))
+				addPendingChange(dns, status, task)
+				continue
+			}
+			switch {
+			case dnsclient.IsZoneLocked(err):
+				lockErrs = append(lockErrs, err)
+			case len(views) == 1:
+				return err
+			case len(zones) == 0 && dnsclient.IsZoneNotFound(err):
+				// Nothing has been written to a view without zone
+				a.logger.Info("No DNS managed zone found, skipping view", "view", view, "name", dns.Spec.Name, "dnsrecord", kutil.ObjectName(dns))
+				continue
+			}
+			errs = append(errs, viewError(view, views, err))
+		}
+	}
+
+	addScheduledChanges(dns, status, dnsClient.ScheduledTasks(), scheduledAt)
+
+	if len(lockErrs) > 0 || len(status.PendingChanges) > 0 || hasCondition(dns, ConditionTypeZoneLocked) {
+		status.Records = fromManagedRecords(dnsClient.ManagedRecords())
+		if err := a.patchStatus(ctx, dns, status, func() {
+			setZoneLockedCondition(dns, lockErrs)
+			setAwaitingApprovalCondition(dns, unscheduledTasks(status.PendingChanges), nil)
+			setChangesScheduledCondition(dns, scheduledChanges(status.PendingChanges))
+		}); err != nil {
+			return err
+		}
+	}
+	switch {
+	case len(errs) == 0 && len(status.PendingChanges) > 0:
+		return pendingChangesError(status.PendingChanges)
+	case len(errs) == 0:
+		return nil
+	case len(views) == 1:
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        errs[0],
+			RequeueAfter: requeueAfter(lockErrs),
+		}
+	default:
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("DNS recordset could not be deleted from all DNS views: %+v", utilerrors.NewAggregate(errs)),
+			RequeueAfter: requeueAfter(lockErrs),
+		}
+	}
+}
+
+// deleteView deletes the record set from the given view.
+func (a *actuator) deleteView(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, config *infoblox.DNSRecordConfig,
+	hostOptions *dnsclient.HostOptions, view string, knownZones []string) error {
 	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, dns, dnsClient)
+	managedZone, err := a.getManagedZone(ctx, dns, dnsClient, view, knownZones)
 	if err != nil {
 		return err
 	}
+	if err := a.checkZoneWritable(ctx, dnsClient, managedZone); err != nil {
+		return err
+	}
 
 	// Delete DNS recordset
-	a.logger.Info("Deleting DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "dnsrecord", kutil.ObjectName(dns))
-	if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType)); err != nil {
-		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not delete DNS recordset in managed zone %s with name %s and type %s: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
-			RequeueAfter: requeueAfterOnProviderError,
+	recordType := string(dns.Spec.RecordType)
+	if config.Alias != nil {
+		recordType = raw.Type_ALIAS
+	}
+	switch {
+	case config.SharedRecord != nil:
+		a.logger.Info("Deleting DNS shared recordset", "managedZone", managedZone.String(), "name", dns.Spec.Name, "type", recordType, "group", config.SharedRecord.Group, "dnsrecord", kutil.ObjectName(dns))
+		if err := dnsClient.DeleteSharedRecordSet(ctx, managedZone, dns.Spec.Name, recordType, config.SharedRecord.Group); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS shared recordset for zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, recordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	case hostOptions != nil:
+		a.logger.Info("Deleting DNS host record addresses", "managedZone", managedZone.String(), "name", dns.Spec.Name, "type", recordType, "dnsrecord", kutil.ObjectName(dns))
+		if err := dnsClient.DeleteHostRecordSet(ctx, managedZone, dns.Spec.Name, recordType); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS host record addresses in managed zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, recordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	default:
+		a.logger.Info("Deleting DNS recordset", "managedZone", managedZone.String(), "name", dns.Spec.Name, "type", recordType, "dnsrecord", kutil.ObjectName(dns))
+		if err := dnsClient.DeleteRecordSet(ctx, managedZone, dns.Spec.Name, recordType); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete DNS recordset in managed zone %s with name %s and type %s: %w", managedZone, dns.Spec.Name, recordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	}
+
+	// Purge the records soft-deleted in this zone, also after no other DNSRecord in the zone is reconciled anymore
+	if softDelete := a.getSoftDeleteOptions(config); softDelete != nil {
+		a.purgeSoftDeletedRecords(ctx, dns, dnsClient, managedZone)
+		if a.purger != nil {
+			a.purger.add(dns.Spec.SecretRef, managedZone, *softDelete, time.Now())
+		}
+	}
+
+	// Delete the DNS managed zone if it has been created by the extension and is empty now
+	if a.zoneCreation != nil && a.zoneCreation.DeleteWhenEmpty {
+		deleted, err := dnsClient.DeleteZoneIfEmpty(ctx, managedZone, a.zoneCreation.OwnerAttribute)
+		if err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not delete empty DNS managed zone %s: %w", managedZone, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		if deleted {
+			a.logger.Info("Deleted empty DNS managed zone", "managedZone", managedZone.String(), "dnsrecord", kutil.ObjectName(dns))
 		}
 	}
 	return nil
@@ -139,28 +635,293 @@ func (a *actuator) Migrate(ctx context.Context, dns *extensionsv1alpha1.DNSRecor
 	return nil
 }
 
-func (a *actuator) getManagedZone(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient) (string, error) {
+// getViews returns the DNS views to write the record set into. The views of the providerConfig take precedence over
+// the comma-separated views key of the secret, which in turn takes precedence over its view key.
+func (a *actuator) getViews(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, config *infoblox.DNSRecordConfig) ([]string, error) {
+	if len(config.Views) > 0 {
+		return dnsclient.ParseViews(strings.Join(config.Views, ","))
+	}
+
+	secret, err := extensionscontroller.GetSecretByReference(ctx, a.Client(), &dns.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if views, ok := secret.Data["views"]; ok {
+		return dnsclient.ParseViews(string(views))
+	}
+	view, ok := secret.Data["view"]
+	if !ok {
+		return nil, fmt.Errorf("no view found")
+	}
+	return []string{string(view)}, nil
+}
+
+// patchStatus patches the status of the DNSRecord with the given provider status, after applying the given changes.
+func (a *actuator) patchStatus(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, status *infoblox.DNSRecordStatus, mutate func()) error {
+	patch := client.MergeFrom(dns.DeepCopy())
+	if mutate != nil {
+		mutate()
+	}
+	providerStatus := &infobloxv1alpha1.DNSRecordStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: infobloxv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "DNSRecordStatus",
+		},
+	}
+	if err := infobloxv1alpha1.Convert_infoblox_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(status, providerStatus, nil); err != nil {
+		return err
+	}
+	dns.Status.ProviderStatus = &runtime.RawExtension{Object: providerStatus}
+	return a.Client().Status().Patch(ctx, dns, patch)
+}
+
+// checkZoneWritable returns a ZoneLockedError if the zone is locked or disabled.
+func (a *actuator) checkZoneWritable(ctx context.Context, dnsClient dnsclient.DNSClient, zone dnsclient.ZoneID) error {
+	err := dnsClient.CheckZoneWritable(ctx, zone)
+	if err == nil || dnsclient.IsZoneLocked(err) {
+		return err
+	}
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("could not check whether DNS managed zone %s is writable: %w", zone, err),
+		RequeueAfter: requeueAfterOnProviderError,
+	}
+}
+
+// hasCondition returns true if the DNSRecord has a condition of the given type with status other than False.
+func hasCondition(dns *extensionsv1alpha1.DNSRecord, conditionType gardencorev1beta1.ConditionType) bool {
+	c := v1beta1helper.GetCondition(dns.Status.Conditions, conditionType)
+	return c != nil && c.Status != gardencorev1beta1.ConditionFalse
+}
+
+// setZoneLockedCondition sets the ZoneLocked condition if there are errors about locked zones, and clears an existing
+// condition otherwise. It returns true if the conditions have been changed.
+func setZoneLockedCondition(dns *extensionsv1alpha1.DNSRecord, lockErrs []error) bool {
+	var condition gardencorev1beta1.Condition
+	if len(lockErrs) > 0 {
+		condition = v1beta1helper.GetOrInitCondition(dns.Status.Conditions, ConditionTypeZoneLocked)
+		condition = v1beta1helper.UpdatedCondition(condition, gardencorev1beta1.ConditionTrue, "ZoneLocked", utilerrors.NewAggregate(lockErrs).Error())
+	} else {
+		existing := v1beta1helper.GetCondition(dns.Status.Conditions, ConditionTypeZoneLocked)
+		if existing == nil || existing.Status == gardencorev1beta1.ConditionFalse {
+			return false
+		}
+		condition = v1beta1helper.UpdatedCondition(*existing, gardencorev1beta1.ConditionFalse, "ZoneWritable", "The DNS managed zones are writable.")
+	}
+	dns.Status.Conditions = v1beta1helper.MergeConditions(dns.Status.Conditions, condition)
+	return true
+}
+
+// requeueAfter returns the backoff after the given errors about locked zones. Zones are usually locked for
+// maintenance, so that retrying soon is pointless.
+func requeueAfter(lockErrs []error) time.Duration {
+	if len(lockErrs) > 0 {
+		return requeueAfterOnZoneLocked
+	}
+	return requeueAfterOnProviderError
+}
+
+// viewError qualifies the error with the view if the record set is written into several views.
+func viewError(view string, views []string, err error) error {
+	if len(views) == 1 {
+		return err
+	}
+	return fmt.Errorf("view %s: %w", view, err)
+}
+
+// getKnownZones returns the zones the record set has been written to by previous reconciliations.
+func getKnownZones(dns *extensionsv1alpha1.DNSRecord, status *infoblox.DNSRecordStatus) []string {
+	zones := append([]string(nil), status.Zones...)
+	if dns.Status.Zone != nil && *dns.Status.Zone != "" && !utils.ValueExists(*dns.Status.Zone, zones) {
+		zones = append(zones, *dns.Status.Zone)
+	}
+	return zones
+}
+
+// zonesInView returns the zones of the given view. Zones without view, which have been stored by older versions of
+// this extension, are only returned if the record set is written into a single view.
+func zonesInView(zones []string, view string, single bool) []string {
+	var res []string
+	for _, zone := range zones {
+		id, err := dnsclient.ParseZoneID(zone)
+		if err != nil {
+			continue
+		}
+		if id.View == view || (id.View == "" && single) {
+			res = append(res, zone)
+		}
+	}
+	return res
+}
+
+// clientOptions returns the options of the DNS client changing the record set of the DNSRecord, scheduling changes
+// at the given time unless it is zero. The record set of a drifted DNSRecord is discovered again instead of being read
+// by the references of its managed records, so that records written by others are replaced as well.
+func (a *actuator) clientOptions(dns *extensionsv1alpha1.DNSRecord, config *infoblox.DNSRecordConfig, status *infoblox.DNSRecordStatus,
+	scheduledAt time.Time) dnsclient.ClientOptions {
+	opts := dnsclient.ClientOptions{
+		ScheduledAt:       scheduledAt,
+		SoftDelete:        a.getSoftDeleteOptions(config),
+		DeletionBudget:    a.deletionBudget,
+		DeletionsApproved: deletionsApproved(dns),
+	}
+	if !hasCondition(dns, ConditionTypeDriftDetected) {
+		opts.ManagedRecords = toManagedRecords(status.Records)
+	}
+	return opts
+}
+
+// getSoftDeleteOptions returns the options for the soft deletion of the records of the DNSRecord, or nil if records
+// are deleted. The providerConfig takes precedence over the controller configuration.
+func (a *actuator) getSoftDeleteOptions(config *infoblox.DNSRecordConfig) *dnsclient.SoftDeleteOptions {
+	enabled := a.softDelete != nil && a.softDelete.Enabled
+	if config.SoftDelete != nil {
+		enabled = *config.SoftDelete
+	}
+	if !enabled {
+		return nil
+	}
+	opts := &dnsclient.SoftDeleteOptions{
+		DeletionAttribute: dnsclient.DefaultDeletionAttribute,
+		Retention:         dnsclient.DefaultSoftDeleteRetention,
+	}
+	if a.softDelete != nil {
+		if a.softDelete.DeletionAttribute != "" {
+			opts.DeletionAttribute = a.softDelete.DeletionAttribute
+		}
+		if a.softDelete.Retention != nil {
+			opts.Retention = a.softDelete.Retention.Duration
+		}
+	}
+	return opts
+}
+
+// getHostOptions returns the options for writing the record set as addresses of a host record, or nil if it is
+// written as plain records. The providerConfig takes precedence over the recordMode key of the secret, which only
+// applies to DNSRecords of type A and AAAA.
+func (a *actuator) getHostOptions(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, config *infoblox.DNSRecordConfig) (*dnsclient.HostOptions, error) {
+	if config.Host != nil {
+		opts := &dnsclient.HostOptions{NetworkView: config.Host.NetworkView}
+		if config.Host.DHCP != nil {
+			opts.ConfigureForDHCP = true
+			opts.MAC = config.Host.DHCP.MAC
+			opts.DUID = config.Host.DHCP.DUID
+		}
+		return opts, nil
+	}
+	if config.Alias != nil || config.SharedRecord != nil || !isAddressRecordType(dns.Spec.RecordType) {
+		return nil, nil
+	}
+
+	secret, err := extensionscontroller.GetSecretByReference(ctx, a.Client(), &dns.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	if string(secret.Data["recordMode"]) != recordModeHost {
+		return nil, nil
+	}
+	return &dnsclient.HostOptions{NetworkView: string(secret.Data["networkView"])}, nil
+}
+
+func isAddressRecordType(recordType extensionsv1alpha1.DNSRecordType) bool {
+	return string(recordType) == raw.Type_A || string(recordType) == raw.Type_AAAA
+}
+
+// nameServerGlue returns the glue addresses of the name servers in the provider config by name.
+func nameServerGlue(config *infoblox.DNSRecordConfig) map[string][]string {
+	glue := make(map[string][]string, len(config.NameServers))
+	for _, ns := range config.NameServers {
+		glue[ns.Name] = append(glue[ns.Name], ns.Addresses...)
+	}
+	return glue
+}
+
+// getManagedZone returns the managed zone of the record set in the given view. The zone given in the spec, or one of
+// the known zones the record set has been written to before, takes precedence over the managed zones of the view.
+func (a *actuator) getManagedZone(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, view string, knownZones []string) (dnsclient.ZoneID, error) {
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
-		return *dns.Spec.Zone, nil
-	case dns.Status.Zone != nil && *dns.Status.Zone != "":
-		return *dns.Status.Zone, nil
+		zone, err := a.resolveZone(ctx, dnsClient, *dns.Spec.Zone, view)
+		if err != nil || zone.View == "" || zone.View == view {
+			return zone, err
+		}
+		// The zone of the spec belongs to another view, the zone with the same name is used in this view
+		return a.resolveZone(ctx, dnsClient, dnsclient.ZoneID{View: view, FQDN: zone.FQDN}.String(), view)
+	case len(knownZones) > 0:
+		// The status contains the view-qualified zone written by a previous reconciliation. Zones stored
+		// in other forms by older versions of this extension are resolved again.
+		zone, err := dnsclient.ParseZoneID(knownZones[0])
+		if err == nil && zone.IsQualified() && zone.Ref == "" {
+			return zone, nil
+		}
+		return a.resolveZone(ctx, dnsClient, knownZones[0], view)
 	default:
 		// The zone is not specified in the resource status or spec. Try to determine the zone by
 		// getting all managed zones of the account and searching for the longest zone name that is a suffix of dns.spec.Name
-		zones, err := dnsClient.GetManagedZones(ctx)
-
+		zone, err := a.findZoneForName(ctx, dns, dnsClient, view)
+		if err != nil && dnsclient.IsZoneNotFound(err) {
+			// The cached zones may be outdated, e.g. if the zone has been created recently
+			a.logger.Info("No DNS managed zone found in cached zones, listing zones again", "view", view, "dnsrecord", kutil.ObjectName(dns))
+			dnsClient.InvalidateManagedZones(view)
+			zone, err = a.findZoneForName(ctx, dns, dnsClient, view)
+		}
 		if err != nil {
-			return "", &reconcilerutils.RequeueAfterError{
-				Cause:        fmt.Errorf("could not get DNS managed zones: %+v", err),
-				RequeueAfter: requeueAfterOnProviderError,
-			}
+			return dnsclient.ZoneID{}, err
+		}
+		return zone.ZoneID, nil
+	}
+}
+
+func (a *actuator) findZoneForName(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, view string) (*dnsclient.Zone, error) {
+	zones, err := dnsClient.GetManagedZones(ctx, view)
+	if err != nil {
+		return nil, &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not get DNS managed zones: %w", err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
+	a.logger.Info("Got DNS managed zones", "zones", len(zones), "dnsrecord", kutil.ObjectName(dns))
+	return dnsclient.FindZoneForName(zones, dns.Spec.Name, view)
+}
+
+// createZone creates the zone for the DNSRecord name if the name is below a parent domain allowed for zone creation.
+func (a *actuator) createZone(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, view string) (dnsclient.ZoneID, error) {
+	zone, ok := dnsclient.ZoneToCreate(dns.Spec.Name, view, a.zoneCreation.ParentDomains)
+	if !ok {
+		return dnsclient.ZoneID{}, fmt.Errorf("could not find DNS managed zone for name %s, and it is not below a parent domain allowed for zone creation", dns.Spec.Name)
+	}
+
+	a.logger.Info("Creating DNS managed zone", "managedZone", zone.String(), "dnsrecord", kutil.ObjectName(dns))
+	created, err := dnsClient.CreateZone(ctx, zone, dnsclient.ZoneCreateOptions{
+		GridPrimaries:   a.zoneCreation.GridPrimaries,
+		GridSecondaries: a.zoneCreation.GridSecondaries,
+		NSGroup:         a.zoneCreation.NSGroup,
+		OwnerAttribute:  a.zoneCreation.OwnerAttribute,
+	})
+	if err != nil {
+		return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not create DNS managed zone %s: %w", zone, err),
+			RequeueAfter: requeueAfterOnProviderError,
 		}
-		a.logger.Info("Got DNS managed zones", "zones", zones, "dnsrecord", kutil.ObjectName(dns))
-		zone := dnsrecord.FindZoneForName(zones, dns.Spec.Name)
-		if zone == "" {
-			return "", fmt.Errorf("could not find DNS managed zone for name %s", dns.Spec.Name)
+	}
+	return created, nil
+}
+
+// resolveZone parses the given zone and looks it up in the grid. Zones given as plain FQDN are looked up in the given view.
+func (a *actuator) resolveZone(ctx context.Context, dnsClient dnsclient.DNSClient, s, view string) (dnsclient.ZoneID, error) {
+	zone, err := dnsclient.ParseZoneID(s)
+	if err != nil {
+		return dnsclient.ZoneID{}, err
+	}
+	if zone.View == "" && zone.Ref == "" {
+		zone.View = view
+	}
+	resolved, err := dnsClient.ResolveZone(ctx, zone)
+	if err != nil {
+		return dnsclient.ZoneID{}, &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not resolve DNS managed zone %s: %w", zone, err),
+			RequeueAfter: requeueAfterOnProviderError,
 		}
-		return zone, nil
 	}
+	return resolved, nil
 }
@@ -15,17 +15,28 @@
 package dnsrecord
 
 import (
+	"time"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
-const DNSType = "infoblox-dns"
+const (
+	DNSType = "infoblox-dns"
+	// ControllerName is the name of the controller, used as source of its events.
+	ControllerName = "infoblox-dnsrecord-controller"
+)
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		ZoneCacheTTL: dnsclient.DefaultZoneCacheTTL,
+	}
 
 	logger = log.Log.WithName("gcp-dnsrecord-controller")
 )
@@ -36,17 +47,57 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// ZoneCacheTTL is the time the managed zones of a grid and view are cached.
+	ZoneCacheTTL time.Duration
+	// ZoneCreation configures the automatic creation of missing zones. Zones are not created if it is nil.
+	ZoneCreation *config.ZoneCreationConfiguration
+	// ChangeSchedule restricts changes of records to a maintenance window. Changes are applied immediately if it is nil.
+	ChangeSchedule *config.ChangeScheduleConfiguration
+	// ServiceRestart enables restarts of the DNS services of a grid after changes. Services are not restarted if it is nil.
+	ServiceRestart *config.ServiceRestartConfiguration
+	// SoftDelete configures the soft deletion of records. Records are only soft-deleted if it is enabled here or in
+	// the providerConfig of a DNSRecord.
+	SoftDelete *config.SoftDeleteConfiguration
+	// DeletionBudget limits the number of deleted records. Deletions are not limited if it is nil.
+	DeletionBudget *config.DeletionBudgetConfiguration
+	// DriftDetection enables the periodic comparison of reconciled DNSRecords with the records in the grid. Drift is
+	// not detected if it is nil.
+	DriftDetection *config.DriftDetectionConfiguration
+	// Conflicts configures the handling of records not written by the extension. Conflicts are only detected for
+	// DNSRecords with a conflict policy in their providerConfig if it is nil.
+	Conflicts *config.ConflictConfiguration
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
-	return dnsrecord.Add(mgr, dnsrecord.AddArgs{
-		Actuator:          NewActuator(logger),
+	dnsclient.SetZoneCacheTTL(opts.ZoneCacheTTL)
+	if opts.ServiceRestart != nil {
+		minInterval := dnsclient.DefaultServiceRestartMinInterval
+		if opts.ServiceRestart.MinInterval != nil {
+			minInterval = opts.ServiceRestart.MinInterval.Duration
+		}
+		if err := mgr.Add(dnsclient.EnableServiceRestarts(minInterval)); err != nil {
+			return err
+		}
+	}
+	act := NewActuator(logger, mgr.GetEventRecorderFor(ControllerName), opts.ZoneCreation, opts.ChangeSchedule, opts.SoftDelete, opts.DeletionBudget, opts.Conflicts)
+	if err := dnsrecord.Add(mgr, dnsrecord.AddArgs{
+		Actuator:          act,
 		ControllerOptions: opts.Controller,
 		Predicates:        dnsrecord.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              DNSType,
-	})
+	}); err != nil {
+		return err
+	}
+	// Records soft-deleted by deleted DNSRecords are purged periodically
+	if err := mgr.Add(act.(*actuator).purger); err != nil {
+		return err
+	}
+	if opts.DriftDetection != nil {
+		return mgr.Add(newDriftDetector(act.(*actuator), opts.DriftDetection))
+	}
+	return nil
 }
 
 // AddToManager adds a controller with the default Options.
@@ -0,0 +1,189 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// requeueAfterOnPendingApproval is the interval in which tasks of changes awaiting approval are polled.
+	requeueAfterOnPendingApproval = time.Minute
+	// requeueAfterOnRejectedChange is the value for RequeueAfter to be returned if a change has been rejected.
+	// The change is only submitted again for a new generation of the DNSRecord.
+	requeueAfterOnRejectedChange = 5 * time.Minute
+
+	// ConditionTypeAwaitingApproval is the type of the DNSRecord condition reporting changes queued for approval.
+	ConditionTypeAwaitingApproval gardencorev1beta1.ConditionType = "AwaitingApproval"
+)
+
+// pendingApprovalTask returns the task of the change if the given error reports a change queued for approval.
+func pendingApprovalTask(err error) (string, bool) {
+	var requeue *reconcilerutils.RequeueAfterError
+	if errors.As(err, &requeue) {
+		err = requeue.Cause
+	}
+	return dnsclient.PendingApprovalTask(err)
+}
+
+// checkPendingChanges polls the tasks of changes queued for approval by previous reconciliations, and drops applied
+// changes from the status. It returns an error while changes are awaiting approval, or have been rejected for the
+// current generation of the DNSRecord, so that the same change is not submitted twice.
+func (a *actuator) checkPendingChanges(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, status *infoblox.DNSRecordStatus) error {
+	if len(status.PendingChanges) == 0 {
+		return nil
+	}
+
+	var (
+		pending   []infoblox.PendingChange
+		waiting   []string
+		rejected  []string
+		scheduled []infoblox.PendingChange
+	)
+	for _, change := range status.PendingChanges {
+		task, err := dnsClient.GetTask(ctx, change.Task)
+		if err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not get state of pending change: %w", err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		switch {
+		case task == nil || task.IsCompleted():
+			a.logger.Info("Pending change has been applied", "task", change.Task, "dnsrecord", kutil.ObjectName(dns))
+		case task.IsRejected() && change.Generation != dns.Generation:
+			a.logger.Info("Dropping rejected change of a previous generation", "task", change.Task, "dnsrecord", kutil.ObjectName(dns))
+		case task.IsRejected():
+			pending = append(pending, change)
+			rejected = append(rejected, fmt.Sprintf("change task %s has been %s", change.Task, task.Reason()))
+		case change.ScheduledTime != nil && change.Generation != dns.Generation:
+			// The scheduled change is superseded by the changes for the current generation
+			if err := dnsClient.CancelTask(ctx, change.Task); err != nil {
+				return &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("could not cancel scheduled change of a previous generation: %w", err),
+					RequeueAfter: requeueAfterOnProviderError,
+				}
+			}
+			a.logger.Info("Cancelled scheduled change of a previous generation", "task", change.Task, "dnsrecord", kutil.ObjectName(dns))
+		case change.ScheduledTime != nil && task.ApprovalStatus != dnsclient.TaskApprovalPending:
+			pending = append(pending, change)
+			scheduled = append(scheduled, change)
+		default:
+			pending = append(pending, change)
+			waiting = append(waiting, change.Task)
+		}
+	}
+
+	status.PendingChanges = pending
+	if err := a.patchStatus(ctx, dns, status, func() {
+		setAwaitingApprovalCondition(dns, waiting, rejected)
+		setChangesScheduledCondition(dns, scheduled)
+	}); err != nil {
+		return err
+	}
+	switch {
+	case len(rejected) > 0:
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("%s, the DNSRecord must be updated to submit the change again", strings.Join(rejected, "; ")),
+			RequeueAfter: requeueAfterOnRejectedChange,
+		}
+	case len(waiting) > 0:
+		return awaitingApprovalError(waiting)
+	case len(scheduled) > 0:
+		return changesScheduledError(scheduled)
+	}
+	return nil
+}
+
+// addPendingChange records the change queued for approval in the given task.
+func addPendingChange(dns *extensionsv1alpha1.DNSRecord, status *infoblox.DNSRecordStatus, task string) {
+	for _, change := range status.PendingChanges {
+		if change.Task == task {
+			return
+		}
+	}
+	status.PendingChanges = append(status.PendingChanges, infoblox.PendingChange{Task: task, Generation: dns.Generation})
+}
+
+// pendingTasks returns the tasks of the given pending changes.
+func pendingTasks(changes []infoblox.PendingChange) []string {
+	tasks := make([]string, 0, len(changes))
+	for _, change := range changes {
+		tasks = append(tasks, change.Task)
+	}
+	return tasks
+}
+
+// unscheduledTasks returns the tasks of the given pending changes which are not scheduled for a maintenance window.
+func unscheduledTasks(changes []infoblox.PendingChange) []string {
+	var tasks []string
+	for _, change := range changes {
+		if change.ScheduledTime == nil {
+			tasks = append(tasks, change.Task)
+		}
+	}
+	return tasks
+}
+
+// pendingChangesError returns the error reporting the given pending changes. Changes awaiting approval take
+// precedence over scheduled ones.
+func pendingChangesError(changes []infoblox.PendingChange) error {
+	if tasks := unscheduledTasks(changes); len(tasks) > 0 {
+		return awaitingApprovalError(tasks)
+	}
+	return changesScheduledError(changes)
+}
+
+func awaitingApprovalError(tasks []string) error {
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("DNS recordset changes are awaiting approval in tasks %s", strings.Join(tasks, ", ")),
+		RequeueAfter: requeueAfterOnPendingApproval,
+	}
+}
+
+// setAwaitingApprovalCondition sets the AwaitingApproval condition according to the tasks awaiting approval and the
+// rejected changes. An existing condition is cleared if there are neither.
+func setAwaitingApprovalCondition(dns *extensionsv1alpha1.DNSRecord, waiting, rejected []string) {
+	var condition gardencorev1beta1.Condition
+	switch {
+	case len(waiting) > 0:
+		condition = v1beta1helper.GetOrInitCondition(dns.Status.Conditions, ConditionTypeAwaitingApproval)
+		condition = v1beta1helper.UpdatedCondition(condition, gardencorev1beta1.ConditionTrue, "AwaitingApproval",
+			fmt.Sprintf("Changes are awaiting approval in tasks %s.", strings.Join(waiting, ", ")))
+	case len(rejected) > 0:
+		condition = v1beta1helper.GetOrInitCondition(dns.Status.Conditions, ConditionTypeAwaitingApproval)
+		condition = v1beta1helper.UpdatedCondition(condition, gardencorev1beta1.ConditionFalse, "ChangeRejected", strings.Join(rejected, "; ")+".")
+	default:
+		existing := v1beta1helper.GetCondition(dns.Status.Conditions, ConditionTypeAwaitingApproval)
+		if existing == nil || (existing.Status == gardencorev1beta1.ConditionFalse && existing.Reason == "ChangesApplied") {
+			return
+		}
+		condition = v1beta1helper.UpdatedCondition(*existing, gardencorev1beta1.ConditionFalse, "ChangesApplied", "All changes have been applied.")
+	}
+	dns.Status.Conditions = v1beta1helper.MergeConditions(dns.Status.Conditions, condition)
+}
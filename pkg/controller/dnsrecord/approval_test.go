@@ -0,0 +1,143 @@
+package dnsrecord
+
+import (
+	"context"
+	"time"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/common"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeTaskClient returns the tasks of a map and records cancelled tasks. Other methods of the DNSClient are not
+// implemented.
+type fakeTaskClient struct {
+	dnsclient.DNSClient
+	tasks     map[string]*dnsclient.Task
+	cancelled []string
+}
+
+func (f *fakeTaskClient) GetTask(_ context.Context, ref string) (*dnsclient.Task, error) {
+	return f.tasks[ref], nil
+}
+
+func (f *fakeTaskClient) CancelTask(_ context.Context, ref string) error {
+	f.cancelled = append(f.cancelled, ref)
+	return nil
+}
+
+var _ = Describe("Pending changes", func() {
+	var (
+		ctx       = context.TODO()
+		a         *actuator
+		dns       *extensionsv1alpha1.DNSRecord
+		dnsClient *fakeTaskClient
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		dns = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "dnsrecord", Namespace: "shoot--foo--bar", Generation: 2},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dns).Build()
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(dns), dns)).To(Succeed())
+		a = &actuator{ClientContext: common.NewClientContext(c, scheme, nil), logger: logr.Discard()}
+		dnsClient = &fakeTaskClient{tasks: map[string]*dnsclient.Task{}}
+	})
+
+	condition := func(conditionType gardencorev1beta1.ConditionType) *gardencorev1beta1.Condition {
+		return v1beta1helper.GetCondition(dns.Status.Conditions, conditionType)
+	}
+
+	It("should report changes awaiting approval", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: dnsclient.TaskApprovalPending, ExecutionStatus: "PENDING"}
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 2}}}
+
+		err := a.checkPendingChanges(ctx, dns, dnsClient, status)
+		Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+		Expect(err.(*reconcilerutils.RequeueAfterError).RequeueAfter).To(Equal(requeueAfterOnPendingApproval))
+		Expect(status.PendingChanges).To(HaveLen(1))
+		Expect(condition(ConditionTypeAwaitingApproval)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("AwaitingApproval"),
+		})))
+	})
+
+	It("should drop applied changes and clear the condition", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: dnsclient.TaskApprovalPending, ExecutionStatus: "PENDING"}
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 2}}}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).NotTo(Succeed())
+
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: "APPROVED", ExecutionStatus: dnsclient.TaskExecutionCompleted}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).To(Succeed())
+		Expect(status.PendingChanges).To(BeEmpty())
+		Expect(condition(ConditionTypeAwaitingApproval)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionFalse),
+			"Reason": Equal("ChangesApplied"),
+		})))
+	})
+
+	It("should drop changes whose task does not exist anymore", func() {
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 2}}}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).To(Succeed())
+		Expect(status.PendingChanges).To(BeEmpty())
+		Expect(condition(ConditionTypeAwaitingApproval)).To(BeNil())
+	})
+
+	It("should report rejected changes of the current generation", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: dnsclient.TaskApprovalRejected, Approver: "admin", ApproverComment: "wrong address"}
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 2}}}
+
+		err := a.checkPendingChanges(ctx, dns, dnsClient, status)
+		Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+		Expect(err.(*reconcilerutils.RequeueAfterError).RequeueAfter).To(Equal(requeueAfterOnRejectedChange))
+		Expect(status.PendingChanges).To(HaveLen(1))
+		Expect(condition(ConditionTypeAwaitingApproval)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("ChangeRejected"),
+			"Message": ContainSubstring("rejected by admin: wrong address"),
+		})))
+	})
+
+	It("should drop rejected changes of previous generations", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: dnsclient.TaskApprovalRejected}
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 1}}}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).To(Succeed())
+		Expect(status.PendingChanges).To(BeEmpty())
+	})
+
+	It("should cancel scheduled changes of previous generations", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: "NONE", ExecutionStatus: "PENDING"}
+		scheduledTime := metav1.NewTime(time.Now().Add(time.Hour))
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 1, ScheduledTime: &scheduledTime}}}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).To(Succeed())
+		Expect(status.PendingChanges).To(BeEmpty())
+		Expect(dnsClient.cancelled).To(ConsistOf("scheduledtask/b25l:1/PENDING"))
+	})
+
+	It("should report scheduled changes of the current generation", func() {
+		dnsClient.tasks["scheduledtask/b25l:1/PENDING"] = &dnsclient.Task{ApprovalStatus: "NONE", ExecutionStatus: "PENDING"}
+		scheduledTime := metav1.NewTime(time.Now().Add(time.Hour))
+		status := &infoblox.DNSRecordStatus{PendingChanges: []infoblox.PendingChange{{Task: "scheduledtask/b25l:1/PENDING", Generation: 2, ScheduledTime: &scheduledTime}}}
+		Expect(a.checkPendingChanges(ctx, dns, dnsClient, status)).NotTo(Succeed())
+		Expect(status.PendingChanges).To(HaveLen(1))
+		Expect(condition(ConditionTypeChangesScheduled)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+		})))
+		Expect(condition(ConditionTypeAwaitingApproval)).To(BeNil())
+	})
+})
@@ -0,0 +1,120 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// EventReasonRecordConflict is the reason of the events reporting records conflicting with the record set.
+	EventReasonRecordConflict = "RecordConflict"
+	// EventReasonRecordsAdopted is the reason of the events reporting records adopted by the extension.
+	EventReasonRecordsAdopted = "RecordsAdopted"
+)
+
+// conflictPolicies are the supported policies for records not written by the extension.
+var conflictPolicies = []string{dnsclient.ConflictPolicyFail, dnsclient.ConflictPolicyAdopt, dnsclient.ConflictPolicyOverwrite}
+
+// getConflictPolicy returns the policy for records not written by the extension and the extensible attribute tagging
+// records written by it. The policy is empty if conflicts are not detected. The providerConfig takes precedence over
+// the controller configuration.
+func (a *actuator) getConflictPolicy(config *infoblox.DNSRecordConfig) (string, string, error) {
+	var policy string
+	ownerAttribute := dnsclient.DefaultOwnerAttribute
+	if a.conflicts != nil {
+		policy = a.conflicts.Policy
+		if policy == "" {
+			policy = dnsclient.ConflictPolicyFail
+		}
+		if a.conflicts.OwnerAttribute != "" {
+			ownerAttribute = a.conflicts.OwnerAttribute
+		}
+	}
+	if config.ConflictPolicy != "" {
+		policy = config.ConflictPolicy
+	}
+	if policy != "" && !utils.ValueExists(policy, conflictPolicies) {
+		return "", "", fmt.Errorf("conflict policy %s not supported, must be one of %v", policy, conflictPolicies)
+	}
+	return policy, ownerAttribute, nil
+}
+
+// resolveConflicts checks the records with the name of the record set in the zone before the record set is written.
+// Records of other types conflicting with a CNAME record set, or with an existing CNAME record, always fail the
+// DNSRecord. Records of the same type not written by the extension are handled according to the conflict policy.
+// Untagged records in zones the record set has been written to before are adopted, as they have been written by
+// older versions of the extension.
+func (a *actuator) resolveConflicts(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, config *infoblox.DNSRecordConfig,
+	hostOptions *dnsclient.HostOptions, zone dnsclient.ZoneID, knownZones []string) error {
+	policy, _, err := a.getConflictPolicy(config)
+	if err != nil || policy == "" {
+		return err
+	}
+	// CNAME and alias records written by the extension are replaced when switching between them
+	recordType, replacedType := string(dns.Spec.RecordType), ""
+	switch {
+	case config.Alias != nil:
+		recordType, replacedType = raw.Type_ALIAS, raw.Type_CNAME
+	case recordType == raw.Type_CNAME:
+		replacedType = raw.Type_ALIAS
+	}
+
+	if err := dnsClient.CheckTypeConflicts(ctx, zone, dns.Spec.Name, recordType, replacedType); err != nil {
+		return a.conflictError(dns, zone, err)
+	}
+	// Host and shared records are not tagged by the extension
+	if hostOptions != nil || config.SharedRecord != nil {
+		return nil
+	}
+	if utils.ValueExists(zone.String(), knownZones) {
+		policy = dnsclient.ConflictPolicyAdopt
+	}
+	adopted, err := dnsClient.AdoptRecords(ctx, zone, dns.Spec.Name, recordType, policy)
+	if err != nil {
+		return a.conflictError(dns, zone, err)
+	}
+	if adopted > 0 {
+		a.logger.Info("Adopted DNS records", "managedZone", zone.String(), "name", dns.Spec.Name, "type", recordType, "count", adopted, "dnsrecord", kutil.ObjectName(dns))
+		a.recorder.Eventf(dns, corev1.EventTypeNormal, EventReasonRecordsAdopted, "Adopted %d %s records %s in zone %s", adopted, recordType, dns.Spec.Name, zone)
+	}
+	return nil
+}
+
+// conflictError reports conflicting records by an event. Other errors are retried like provider errors.
+func (a *actuator) conflictError(dns *extensionsv1alpha1.DNSRecord, zone dnsclient.ZoneID, err error) error {
+	if dnsclient.IsConflict(err) {
+		a.recorder.Event(dns, corev1.EventTypeWarning, EventReasonRecordConflict, err.Error())
+		return err
+	}
+	if _, ok := dnsclient.PendingApprovalTask(err); ok {
+		return err
+	}
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("could not check DNS records conflicting with the recordset in managed zone %s: %w", zone, err),
+		RequeueAfter: requeueAfterOnProviderError,
+	}
+}
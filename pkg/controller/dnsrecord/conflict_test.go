@@ -0,0 +1,81 @@
+package dnsrecord
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Switching between CNAME and alias records", func() {
+	var (
+		ctx  = context.TODO()
+		zone = dnsclient.ZoneID{View: "default", FQDN: "example.com"}
+		a    *actuator
+		dns  *extensionsv1alpha1.DNSRecord
+		conn *fake.Connector
+	)
+
+	BeforeEach(func() {
+		a = &actuator{
+			logger:    logr.Discard(),
+			recorder:  record.NewFakeRecorder(10),
+			conflicts: &config.ConflictConfiguration{Policy: dnsclient.ConflictPolicyFail},
+		}
+		dns = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "dnsrecord", Namespace: "shoot--foo--bar"},
+			Spec: extensionsv1alpha1.DNSRecordSpec{
+				Name:       "www.example.com",
+				RecordType: extensionsv1alpha1.DNSRecordTypeCNAME,
+				Values:     []string{"lb.example.com"},
+			},
+		}
+		conn = fake.NewConnector()
+		conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default"})
+	})
+
+	reconcile := func(config *infoblox.DNSRecordConfig) error {
+		dnsClient := dnsclient.NewDNSClientFromConnector(conn, "conflict-grid", dnsclient.ClientOptions{OwnerAttribute: dnsclient.DefaultOwnerAttribute})
+		_, err := a.reconcileView(ctx, dns, dnsClient, config, &infoblox.DNSRecordStatus{}, nil, "default", []string{zone.String()})
+		return err
+	}
+
+	recordTypes := func() []string {
+		var types []string
+		for ref := range conn.Objects {
+			if objectType, _, _ := strings.Cut(ref, "/"); strings.HasPrefix(objectType, "record:") {
+				types = append(types, objectType)
+			}
+		}
+		return types
+	}
+
+	It("should replace CNAME records by alias records and back", func() {
+		Expect(reconcile(&infoblox.DNSRecordConfig{})).To(Succeed())
+		Expect(recordTypes()).To(ConsistOf("record:cname"))
+
+		Expect(reconcile(&infoblox.DNSRecordConfig{Alias: &infoblox.AliasConfig{TargetType: "A"}})).To(Succeed())
+		Expect(recordTypes()).To(ConsistOf("record:alias"))
+
+		Expect(reconcile(&infoblox.DNSRecordConfig{})).To(Succeed())
+		Expect(recordTypes()).To(ConsistOf("record:cname"))
+	})
+
+	It("should not replace CNAME records not written by the extension", func() {
+		conn.Add("record:cname", map[string]interface{}{"name": "www.example.com", "canonical": "other.example.com", "view": "default"})
+
+		err := reconcile(&infoblox.DNSRecordConfig{Alias: &infoblox.AliasConfig{TargetType: "A"}})
+		Expect(dnsclient.IsConflict(err)).To(BeTrue())
+		Expect(recordTypes()).To(ConsistOf("record:cname"))
+	})
+})
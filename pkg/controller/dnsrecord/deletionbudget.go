@@ -0,0 +1,81 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationApproveDeletions is the annotation approving the deletions of records blocked by the deletion budget
+	// for the next reconciliation or deletion of the DNSRecord. It is removed once the DNSRecord has been reconciled.
+	AnnotationApproveDeletions = "infoblox.dns.provider.extensions.gardener.cloud/approve-deletions"
+
+	// EventReasonDeletionBlocked is the reason of the events reporting deletions blocked by the deletion budget.
+	EventReasonDeletionBlocked = "DeletionBlocked"
+)
+
+// newDeletionBudget creates the deletion budget of the given configuration, or nil if deletions are not limited.
+func newDeletionBudget(budget *config.DeletionBudgetConfiguration) *dnsclient.DeletionBudget {
+	if budget == nil {
+		return nil
+	}
+	window := defaultDeletionBudgetWindow
+	if budget.Window != nil {
+		window = budget.Window.Duration
+	}
+	return dnsclient.NewDeletionBudget(budget.MaxPerOperation, budget.MaxPerWindow, window)
+}
+
+// deletionsApproved returns true if an operator has approved the deletions blocked by the deletion budget.
+func deletionsApproved(dns *extensionsv1alpha1.DNSRecord) bool {
+	return dns.Annotations[AnnotationApproveDeletions] == "true"
+}
+
+// deletionBlocked returns true if the given error reports deletions blocked by the deletion budget.
+func deletionBlocked(err error) bool {
+	var requeue *reconcilerutils.RequeueAfterError
+	if errors.As(err, &requeue) {
+		err = requeue.Cause
+	}
+	return dnsclient.IsDeletionBudgetExceeded(err)
+}
+
+// reportBlockedDeletion emits an event for deletions blocked by the deletion budget.
+func (a *actuator) reportBlockedDeletion(dns *extensionsv1alpha1.DNSRecord, err error) {
+	a.logger.Info("Deletion of records blocked by the deletion budget", "error", err.Error(), "dnsrecord", kutil.ObjectName(dns))
+	a.recorder.Eventf(dns, corev1.EventTypeWarning, EventReasonDeletionBlocked,
+		"%s, annotate the DNSRecord with %s=true to approve", err, AnnotationApproveDeletions)
+}
+
+// removeDeletionApproval removes the approval of blocked deletions once the DNSRecord has been reconciled.
+func (a *actuator) removeDeletionApproval(ctx context.Context, dns *extensionsv1alpha1.DNSRecord) error {
+	if _, ok := dns.Annotations[AnnotationApproveDeletions]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(dns.DeepCopy())
+	delete(dns.Annotations, AnnotationApproveDeletions)
+	return a.Client().Patch(ctx, dns, patch)
+}
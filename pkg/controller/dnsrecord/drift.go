@@ -0,0 +1,389 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/config"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/helper"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// ConditionTypeDriftDetected is the type of the DNSRecord condition reporting record sets changed or deleted
+	// outside of the extension.
+	ConditionTypeDriftDetected gardencorev1beta1.ConditionType = "DriftDetected"
+
+	// EventReasonDriftDetected is the reason of the events reporting drifted record sets.
+	EventReasonDriftDetected = "DriftDetected"
+
+	// DriftPolicyReport only reports drifted record sets.
+	DriftPolicyReport = "Report"
+	// DriftPolicyRepair reports drifted record sets and reconciles their DNSRecords again.
+	DriftPolicyRepair = "Repair"
+
+	// defaultResyncPeriod is the default interval in which zone serials are polled.
+	defaultResyncPeriod = 5 * time.Minute
+	// defaultFullResyncPeriod is the default interval in which DNSRecords are verified regardless of zone serials.
+	defaultFullResyncPeriod = 24 * time.Hour
+)
+
+var (
+	driftDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "infoblox_drift_detected_total",
+		Help: "Number of drifted record sets detected by the periodic resync, by policy.",
+	}, []string{"policy"})
+	driftedDNSRecords = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "infoblox_drifted_dnsrecords",
+		Help: "Number of DNSRecords whose record sets differed from their spec at the last resync.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(driftDetected, driftedDNSRecords)
+}
+
+// driftDetector periodically compares the record sets of reconciled DNSRecords with the records in the grid.
+// The record sets are only compared if the SOA serial of one of their zones has changed since they have been
+// verified, or if the last verification is older than the full resync period.
+type driftDetector struct {
+	actuator     *actuator
+	period       time.Duration
+	policy       string
+	zonePolicies map[string]string
+	serials      *dnsclient.ZoneSerials
+}
+
+// resyncRun holds the state of one resync.
+type resyncRun struct {
+	now time.Time
+	// serials are the polled serials by zone key. Each zone is polled once per resync.
+	serials map[string]uint32
+	// unserialized are the zone keys of zones without serial, e.g. delegated zones. Their record sets are always compared.
+	unserialized map[string]bool
+	// verified are the zone keys whose record sets have been compared.
+	verified map[string]bool
+	// failed are the zone keys for which comparing a record set or reporting its drift has failed.
+	failed map[string]bool
+}
+
+// newDriftDetector creates a drift detector using the clients of the given actuator.
+func newDriftDetector(a *actuator, cfg *config.DriftDetectionConfiguration) *driftDetector {
+	d := &driftDetector{
+		actuator:     a,
+		period:       defaultResyncPeriod,
+		policy:       DriftPolicyReport,
+		zonePolicies: cfg.ZonePolicies,
+	}
+	if cfg.ResyncPeriod != nil && cfg.ResyncPeriod.Duration > 0 {
+		d.period = cfg.ResyncPeriod.Duration
+	}
+	fullPeriod := defaultFullResyncPeriod
+	if cfg.FullResyncPeriod != nil {
+		fullPeriod = cfg.FullResyncPeriod.Duration
+	}
+	d.serials = dnsclient.NewZoneSerials(fullPeriod)
+	if cfg.Policy != "" {
+		d.policy = cfg.Policy
+	}
+	return d
+}
+
+// Start runs the drift detection until the context is cancelled. It implements manager.Runnable.
+func (d *driftDetector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.resync(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only the leader repairs record sets.
+func (d *driftDetector) NeedLeaderElection() bool {
+	return true
+}
+
+// resync checks all DNSRecords of this extension and updates the number of drifted DNSRecords.
+func (d *driftDetector) resync(ctx context.Context) {
+	list := &extensionsv1alpha1.DNSRecordList{}
+	if err := d.actuator.Client().List(ctx, list); err != nil {
+		d.actuator.logger.Error(err, "Could not list DNSRecords for drift detection")
+		return
+	}
+	run := &resyncRun{
+		now:          time.Now(),
+		serials:      map[string]uint32{},
+		unserialized: map[string]bool{},
+		verified:     map[string]bool{},
+		failed:       map[string]bool{},
+	}
+	drifted, checked := 0, 0
+	for i := range list.Items {
+		dns := &list.Items[i]
+		if dns.Spec.Type != DNSType || !resyncable(dns) {
+			continue
+		}
+		ok, compared, err := d.check(ctx, dns, run)
+		if err != nil {
+			d.actuator.logger.Error(err, "Could not check DNS recordset for drift", "dnsrecord", kutil.ObjectName(dns))
+			continue
+		}
+		if compared {
+			checked++
+		}
+		if ok {
+			drifted++
+		}
+	}
+
+	// Zones are only skipped by the next resync if all their record sets have been compared successfully
+	for key, serial := range run.serials {
+		if run.verified[key] && !run.failed[key] {
+			d.serials.Verified(key, serial, run.now)
+		}
+	}
+	d.serials.Forget(func(key string) bool {
+		_, ok := run.serials[key]
+		return ok
+	})
+	d.actuator.logger.Info("Resynced DNSRecords", "zones", len(run.serials), "compared", checked, "drifted", drifted)
+	driftedDNSRecords.Set(float64(drifted))
+}
+
+// resyncable returns true if the DNSRecord has been reconciled successfully and no operation is in progress.
+func resyncable(dns *extensionsv1alpha1.DNSRecord) bool {
+	if dns.DeletionTimestamp != nil || dns.Status.ObservedGeneration != dns.Generation {
+		return false
+	}
+	if _, ok := dns.Annotations[v1beta1constants.GardenerOperation]; ok {
+		return false
+	}
+	lastOp := dns.Status.LastOperation
+	return lastOp != nil && lastOp.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+// check compares the record set of the DNSRecord with the records in the grid. Drift is reported by a condition,
+// an event, and a metric, and the DNSRecord is reconciled again if the policy of a drifted zone is Repair.
+// It returns true if the record set has drifted, and whether it has been compared or skipped because its zones
+// have not changed.
+func (d *driftDetector) check(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, run *resyncRun) (bool, bool, error) {
+	a := d.actuator
+	config, err := helper.DNSRecordConfigFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return false, false, err
+	}
+	status, err := helper.DNSRecordStatusFromDNSRecord(a.Decoder(), dns)
+	if err != nil {
+		return false, false, err
+	}
+	// Changes awaiting approval or scheduled are expected to differ from the grid
+	if len(status.PendingChanges) > 0 {
+		return false, false, nil
+	}
+	hostOptions, err := a.getHostOptions(ctx, dns, config)
+	if err != nil {
+		return false, false, err
+	}
+	views, err := a.getViews(ctx, dns, config)
+	if err != nil {
+		return false, false, err
+	}
+
+	dnsClient, err := dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), dns.Spec.SecretRef, dnsclient.ClientOptions{SoftDelete: a.getSoftDeleteOptions(config)})
+	if err != nil {
+		return false, false, err
+	}
+
+	// Determine the zones of the record set in its views
+	knownZones := getKnownZones(dns, status)
+	var (
+		zones  []dnsclient.ZoneID
+		drifts []string
+		repair bool
+	)
+	for _, view := range views {
+		known := zonesInView(knownZones, view, len(views) == 1)
+		if len(known) == 0 {
+			drifts = append(drifts, fmt.Sprintf("view %s: record set not written", view))
+			repair = repair || d.policy == DriftPolicyRepair
+			continue
+		}
+		zone, err := dnsclient.ParseZoneID(known[0])
+		if err != nil || !zone.IsQualified() || zone.Ref != "" {
+			if zone, err = a.resolveZone(ctx, dnsClient, known[0], view); err != nil {
+				return false, false, err
+			}
+		}
+		zones = append(zones, zone)
+	}
+
+	// Only compare the record set if one of its zones has changed since it has been verified
+	keys := make([]string, 0, len(zones))
+	changed := len(drifts) > 0
+	for _, zone := range zones {
+		key := zoneSerialKey(dns, zone)
+		keys = append(keys, key)
+		if run.unserialized[key] {
+			changed = true
+			continue
+		}
+		serial, ok := run.serials[key]
+		if !ok {
+			if serial, err = dnsClient.GetZoneSerial(ctx, zone); dnsclient.IsNoZoneSerial(err) {
+				run.unserialized[key] = true
+				changed = true
+				continue
+			} else if err != nil {
+				return false, false, fmt.Errorf("could not get SOA serial of DNS managed zone %s: %w", zone, err)
+			}
+			run.serials[key] = serial
+		}
+		changed = changed || d.serials.Changed(key, serial, run.now)
+	}
+	if !changed {
+		return hasCondition(dns, ConditionTypeDriftDetected), false, nil
+	}
+	for _, key := range keys {
+		run.verified[key] = true
+	}
+	failed := func(err error) (bool, bool, error) {
+		for _, key := range keys {
+			run.failed[key] = true
+		}
+		return false, true, err
+	}
+
+	desired := desiredRecordSet(dns, config, status, hostOptions != nil)
+	for _, zone := range zones {
+		drift, err := dnsClient.GetRecordSetDrift(ctx, zone, desired)
+		if err != nil {
+			return failed(fmt.Errorf("could not compare DNS recordset in managed zone %s: %w", zone, err))
+		}
+		if drift != nil {
+			drifts = append(drifts, fmt.Sprintf("zone %s: %s", zone, drift))
+			repair = repair || d.policyFor(zone) == DriftPolicyRepair
+		}
+	}
+
+	patch := client.MergeFrom(dns.DeepCopy())
+	if setDriftDetectedCondition(dns, drifts) {
+		if err := a.Client().Status().Patch(ctx, dns, patch); err != nil {
+			return failed(err)
+		}
+	}
+	if len(drifts) == 0 {
+		return false, true, nil
+	}
+
+	policy := DriftPolicyReport
+	if repair {
+		policy = DriftPolicyRepair
+	}
+	message := strings.Join(drifts, "; ")
+	a.logger.Info("DNS recordset drifted from DNSRecord", "drift", message, "policy", policy, "dnsrecord", kutil.ObjectName(dns))
+	driftDetected.WithLabelValues(policy).Inc()
+	a.recorder.Eventf(dns, corev1.EventTypeWarning, EventReasonDriftDetected, "DNS recordset differs from the DNSRecord: %s", message)
+	if repair {
+		// Reconcile the DNSRecord again to write the record set
+		patch := client.MergeFrom(dns.DeepCopy())
+		kutil.SetMetaDataAnnotation(dns, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := a.Client().Patch(ctx, dns, patch); err != nil {
+			return failed(err)
+		}
+	}
+	return true, true, nil
+}
+
+// zoneSerialKey returns the key of the serial of the given zone. Zones are distinguished by the secret of the
+// DNSRecord, as secrets may refer to different grids.
+func zoneSerialKey(dns *extensionsv1alpha1.DNSRecord, zone dnsclient.ZoneID) string {
+	return dns.Spec.SecretRef.Namespace + "/" + dns.Spec.SecretRef.Name + "/" + zone.String()
+}
+
+// policyFor returns the drift policy of the given zone.
+func (d *driftDetector) policyFor(zone dnsclient.ZoneID) string {
+	if policy, ok := dnsclient.LookupZonePolicy(d.zonePolicies, zone); ok {
+		return policy
+	}
+	return d.policy
+}
+
+// desiredRecordSet returns the record set the DNSRecord is expected to have in each of its zones.
+func desiredRecordSet(dns *extensionsv1alpha1.DNSRecord, config *infoblox.DNSRecordConfig, status *infoblox.DNSRecordStatus, host bool) dnsclient.DesiredRecordSet {
+	desired := dnsclient.DesiredRecordSet{
+		Name:       dns.Spec.Name,
+		RecordType: string(dns.Spec.RecordType),
+		Values:     dns.Spec.Values,
+		TTL:        extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL),
+		Host:       host,
+	}
+	if config.NextAvailableIP != nil && status.AllocatedAddress != "" {
+		desired.Values = []string{status.AllocatedAddress}
+	}
+	if config.Alias != nil {
+		desired.TargetType = config.Alias.TargetType
+	}
+	if len(config.NameServers) > 0 {
+		desired.Glue = nameServerGlue(config)
+	}
+	if config.SharedRecord != nil {
+		desired.Shared = true
+		desired.SharedRecordGroup = config.SharedRecord.Group
+	}
+	return desired
+}
+
+// setDriftDetectedCondition sets the DriftDetected condition if the record set has drifted, and clears an existing
+// condition otherwise. It returns true if the conditions have been changed.
+func setDriftDetectedCondition(dns *extensionsv1alpha1.DNSRecord, drifts []string) bool {
+	var condition gardencorev1beta1.Condition
+	if len(drifts) > 0 {
+		condition = v1beta1helper.GetOrInitCondition(dns.Status.Conditions, ConditionTypeDriftDetected)
+		message := strings.Join(drifts, "; ")
+		if condition.Status == gardencorev1beta1.ConditionTrue && condition.Message == message {
+			return false
+		}
+		condition = v1beta1helper.UpdatedCondition(condition, gardencorev1beta1.ConditionTrue, "RecordSetDrifted", message)
+	} else {
+		existing := v1beta1helper.GetCondition(dns.Status.Conditions, ConditionTypeDriftDetected)
+		if existing == nil || existing.Status == gardencorev1beta1.ConditionFalse {
+			return false
+		}
+		condition = v1beta1helper.UpdatedCondition(*existing, gardencorev1beta1.ConditionFalse, "RecordSetInSync", "The DNS recordset matches the DNSRecord.")
+	}
+	dns.Status.Conditions = v1beta1helper.MergeConditions(dns.Status.Conditions, condition)
+	return true
+}
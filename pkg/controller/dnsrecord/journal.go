@@ -0,0 +1,120 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// EventReasonChangeUndone is the reason of the events reporting replacements of records which have been undone.
+const EventReasonChangeUndone = "ChangeUndone"
+
+// journalChanges returns the journal storing replacements of records in the provider status of the DNSRecord before
+// they are applied. A replacement interrupted between the deletion of the replaced records and the creation of the
+// new ones is finished by the next successful write of the record set, or undone if the record set cannot be written.
+func (a *actuator) journalChanges(dns *extensionsv1alpha1.DNSRecord, status *infoblox.DNSRecordStatus) dnsclient.ChangeJournal {
+	return func(ctx context.Context, change dnsclient.RecordSetChange) error {
+		entry := infoblox.RecordSetChange{
+			Zone:       change.Zone.String(),
+			RecordType: change.RecordType,
+			Values:     change.Values,
+			TTL:        change.TTL,
+			TargetType: change.TargetType,
+		}
+		for _, r := range change.Previous {
+			entry.Previous = append(entry.Previous, infoblox.JournaledRecord{Ref: r.Ref, Value: r.Value, TTL: r.TTL, TargetType: r.TargetType})
+		}
+		status.Changes = append(removeChanges(status.Changes, []string{entry.Zone}), entry)
+		// The records of the zone are discovered again if the change is interrupted
+		status.Records = removeRecords(status.Records, entry.Zone)
+		return a.patchStatus(ctx, dns, status, nil)
+	}
+}
+
+// undoChanges undoes the journaled replacements of records in the given zones, so that the records replaced by them
+// are restored. Replacements which cannot be undone are kept, so that undoing them is retried.
+func (a *actuator) undoChanges(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, status *infoblox.DNSRecordStatus, zones []string) {
+	var kept []infoblox.RecordSetChange
+	for _, change := range status.Changes {
+		if !utils.ValueExists(change.Zone, zones) {
+			kept = append(kept, change)
+			continue
+		}
+		zone, err := dnsclient.ParseZoneID(change.Zone)
+		if err == nil {
+			err = dnsClient.UndoRecordSetChange(ctx, toRecordSetChange(dns, zone, change))
+		}
+		if err != nil {
+			a.logger.Error(err, "Could not undo interrupted DNS recordset change", "managedZone", change.Zone, "name", dns.Spec.Name, "type", change.RecordType, "dnsrecord", kutil.ObjectName(dns))
+			kept = append(kept, change)
+			continue
+		}
+		a.logger.Info("Undid interrupted DNS recordset change", "managedZone", change.Zone, "name", dns.Spec.Name, "type", change.RecordType, "dnsrecord", kutil.ObjectName(dns))
+		a.recorder.Eventf(dns, corev1.EventTypeWarning, EventReasonChangeUndone, "Restored %d %s records %s in zone %s, as replacing them by %v could not be completed",
+			len(change.Previous), change.RecordType, dns.Spec.Name, change.Zone, change.Values)
+	}
+	if len(kept) == len(status.Changes) {
+		return
+	}
+	status.Changes = kept
+	if err := a.patchStatus(ctx, dns, status, nil); err != nil {
+		a.logger.Error(err, "Could not remove undone DNS recordset changes from status", "dnsrecord", kutil.ObjectName(dns))
+	}
+}
+
+// toRecordSetChange converts the journaled replacement of records of the DNSRecord into a change of the DNS client.
+func toRecordSetChange(dns *extensionsv1alpha1.DNSRecord, zone dnsclient.ZoneID, change infoblox.RecordSetChange) dnsclient.RecordSetChange {
+	res := dnsclient.RecordSetChange{
+		Zone:       zone,
+		View:       zone.View,
+		Name:       dns.Spec.Name,
+		RecordType: change.RecordType,
+		Values:     change.Values,
+		TTL:        change.TTL,
+		TargetType: change.TargetType,
+	}
+	for _, r := range change.Previous {
+		res.Previous = append(res.Previous, dnsclient.JournaledRecord{Ref: r.Ref, Value: r.Value, TTL: r.TTL, TargetType: r.TargetType})
+	}
+	return res
+}
+
+// removeChanges returns the journaled replacements of records which are not in one of the given zones.
+func removeChanges(changes []infoblox.RecordSetChange, zones []string) []infoblox.RecordSetChange {
+	var res []infoblox.RecordSetChange
+	for _, change := range changes {
+		if !utils.ValueExists(change.Zone, zones) {
+			res = append(res, change)
+		}
+	}
+	return res
+}
+
+// changeZones returns the zones of the given journaled replacements of records.
+func changeZones(changes []infoblox.RecordSetChange) []string {
+	var zones []string
+	for _, change := range changes {
+		zones = append(zones, change.Zone)
+	}
+	return zones
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// toManagedRecords converts the records stored in the provider status into the managed records of the DNS client.
+// Records of zones which cannot be parsed are skipped, so that their record sets are discovered again.
+func toManagedRecords(records []infoblox.ManagedRecord) []dnsclient.ManagedRecord {
+	var res []dnsclient.ManagedRecord
+	for _, r := range records {
+		zone, err := dnsclient.ParseZoneID(r.Zone)
+		if err != nil {
+			continue
+		}
+		res = append(res, dnsclient.ManagedRecord{
+			Zone:       zone,
+			Ref:        r.Ref,
+			View:       r.View,
+			Name:       r.Name,
+			RecordType: r.RecordType,
+			Value:      r.Value,
+			TTL:        r.TTL,
+		})
+	}
+	return res
+}
+
+// fromManagedRecords converts the managed records of the DNS client into the records stored in the provider status.
+func fromManagedRecords(records []dnsclient.ManagedRecord) []infoblox.ManagedRecord {
+	var res []infoblox.ManagedRecord
+	for _, r := range records {
+		res = append(res, infoblox.ManagedRecord{
+			Zone:       r.Zone.String(),
+			Ref:        r.Ref,
+			View:       r.View,
+			Name:       r.Name,
+			RecordType: r.RecordType,
+			Value:      r.Value,
+			TTL:        r.TTL,
+		})
+	}
+	return res
+}
+
+// removeRecords returns the records stored in the provider status which are not in the given zone.
+func removeRecords(records []infoblox.ManagedRecord, zone string) []infoblox.ManagedRecord {
+	var res []infoblox.ManagedRecord
+	for _, r := range records {
+		if r.Zone != zone {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// storeManagedRecords stores the records known to the DNS client in the provider status if they have changed, so that
+// record sets changed by a failed reconciliation are discovered again instead of being read by outdated references.
+func (a *actuator) storeManagedRecords(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsClient dnsclient.DNSClient, status *infoblox.DNSRecordStatus) error {
+	records := fromManagedRecords(dnsClient.ManagedRecords())
+	if equality.Semantic.DeepEqual(records, status.Records) {
+		return nil
+	}
+	status.Records = records
+	return a.patchStatus(ctx, dns, status, nil)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// purgePeriod is the interval in which the zones of deleted DNSRecords are purged. The DNS client purges the
+	// records of a zone at most once per purge interval, so that shorter periods do not cause more purges.
+	purgePeriod = 15 * time.Minute
+	// purgeInterval is the minimum time between two purges of the records of a zone by the DNS client.
+	purgeInterval = time.Hour
+)
+
+// softDeletePurger periodically purges the records soft-deleted by deleted DNSRecords. Other soft-deleted records are
+// purged by the reconciliations of the DNSRecords in their zones, which do not happen anymore once the last DNSRecord
+// of a zone has been deleted. The zones are kept in memory until the retention of their records has passed.
+type softDeletePurger struct {
+	actuator *actuator
+	// newClient creates the DNS client for a zone.
+	newClient func(ctx context.Context, secretRef corev1.SecretReference, opts dnsclient.ClientOptions) (dnsclient.DNSClient, error)
+
+	lock  sync.Mutex
+	zones map[string]*purgeZone
+}
+
+// purgeZone is a zone with records soft-deleted by deleted DNSRecords.
+type purgeZone struct {
+	secretRef  corev1.SecretReference
+	zone       dnsclient.ZoneID
+	softDelete dnsclient.SoftDeleteOptions
+	// expiry is the time the retention of the last soft-deleted record passes.
+	expiry time.Time
+}
+
+// newSoftDeletePurger creates a purger using the clients of the given actuator.
+func newSoftDeletePurger(a *actuator) *softDeletePurger {
+	p := &softDeletePurger{
+		actuator: a,
+		zones:    map[string]*purgeZone{},
+	}
+	p.newClient = func(ctx context.Context, secretRef corev1.SecretReference, opts dnsclient.ClientOptions) (dnsclient.DNSClient, error) {
+		return dnsclient.NewDNSClientFromSecretRef(ctx, a.Client(), secretRef, opts)
+	}
+	return p
+}
+
+// add registers the zone a record set has been soft-deleted from with the given options, so that the records are
+// purged once their retention has passed.
+func (p *softDeletePurger) add(secretRef corev1.SecretReference, zone dnsclient.ZoneID, softDelete dnsclient.SoftDeleteOptions, now time.Time) {
+	key := secretRef.Namespace + "/" + secretRef.Name + "/" + zone.String()
+	expiry := now.Add(softDelete.Retention)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if z, ok := p.zones[key]; ok && z.expiry.After(expiry) {
+		expiry = z.expiry
+	}
+	p.zones[key] = &purgeZone{secretRef: secretRef, zone: zone, softDelete: softDelete, expiry: expiry}
+}
+
+// Start purges the registered zones until the context is cancelled. It implements manager.Runnable.
+func (p *softDeletePurger) Start(ctx context.Context) error {
+	ticker := time.NewTicker(purgePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.purge(ctx, time.Now())
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only the leader deletes records.
+func (p *softDeletePurger) NeedLeaderElection() bool {
+	return true
+}
+
+// purge purges the soft-deleted records of all registered zones. A zone is dropped once it has been purged
+// successfully a purge interval after the retention of its last soft-deleted record has passed. The records are
+// purged by then, either in this purge or in one after the retention within the purge interval.
+func (p *softDeletePurger) purge(ctx context.Context, now time.Time) {
+	p.lock.Lock()
+	zones := make(map[string]purgeZone, len(p.zones))
+	for key, z := range p.zones {
+		zones[key] = *z
+	}
+	p.lock.Unlock()
+
+	for key, z := range zones {
+		softDelete := z.softDelete
+		dnsClient, err := p.newClient(ctx, z.secretRef, dnsclient.ClientOptions{SoftDelete: &softDelete, DeletionBudget: p.actuator.deletionBudget})
+		if err != nil {
+			p.actuator.logger.Error(err, "Could not create DNS client to purge soft-deleted records", "managedZone", z.zone.String())
+			continue
+		}
+		purged, err := dnsClient.PurgeSoftDeletedRecords(ctx, z.zone)
+		dnsClient.RestartServicesIfNeeded()
+		if err != nil {
+			p.actuator.logger.Error(err, "Could not purge soft-deleted records", "managedZone", z.zone.String())
+			continue
+		}
+		if purged > 0 {
+			p.actuator.logger.Info("Purged soft-deleted records", "managedZone", z.zone.String(), "count", purged)
+		}
+		if now.After(z.expiry.Add(purgeInterval)) {
+			p.lock.Lock()
+			if current, ok := p.zones[key]; ok && !current.expiry.After(z.expiry) {
+				delete(p.zones, key)
+			}
+			p.lock.Unlock()
+		}
+	}
+}
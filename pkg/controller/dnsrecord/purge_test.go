@@ -0,0 +1,84 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dnsclient "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Purging soft-deleted records of deleted DNSRecords", func() {
+	var (
+		ctx        = context.TODO()
+		zone       = dnsclient.ZoneID{View: "default", FQDN: "example.com"}
+		secretRef  = corev1.SecretReference{Namespace: "shoot--foo--bar", Name: "infoblox"}
+		softDelete = dnsclient.SoftDeleteOptions{DeletionAttribute: dnsclient.DefaultDeletionAttribute, Retention: time.Hour}
+		now        time.Time
+		conn       *fake.Connector
+		clientErr  error
+		p          *softDeletePurger
+		grid       int
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		conn = fake.NewConnector()
+		clientErr = nil
+		// purges are rate-limited per grid and zone, so every test uses its own grid
+		grid++
+		host := fmt.Sprintf("purge-grid-%d", grid)
+		p = newSoftDeletePurger(&actuator{logger: logr.Discard()})
+		p.newClient = func(_ context.Context, ref corev1.SecretReference, opts dnsclient.ClientOptions) (dnsclient.DNSClient, error) {
+			Expect(ref).To(Equal(secretRef))
+			if clientErr != nil {
+				return nil, clientErr
+			}
+			return dnsclient.NewDNSClientFromConnector(conn, host, opts), nil
+		}
+	})
+
+	addDeleted := func(name string, deletedAt time.Time) string {
+		return conn.Add("record:a", map[string]interface{}{
+			"name": name, "ipv4addr": "1.2.3.4", "view": "default", "zone": "example.com", "disable": true,
+			"extattrs": map[string]interface{}{dnsclient.DefaultDeletionAttribute: map[string]interface{}{"value": deletedAt.UTC().Format(time.RFC3339)}},
+		})
+	}
+
+	It("should purge expired records and keep the zone until the retention has passed", func() {
+		expired := addDeleted("old.example.com", now.Add(-2*time.Hour))
+		recent := addDeleted("new.example.com", now)
+		p.add(secretRef, zone, softDelete, now)
+
+		p.purge(ctx, now)
+		Expect(conn.Get(expired)).To(BeNil())
+		Expect(conn.Get(recent)).NotTo(BeNil())
+		Expect(p.zones).To(HaveLen(1))
+
+		p.purge(ctx, now.Add(softDelete.Retention+purgeInterval+time.Minute))
+		Expect(p.zones).To(BeEmpty())
+	})
+
+	It("should keep the latest expiry of a zone", func() {
+		p.add(secretRef, zone, softDelete, now.Add(time.Hour))
+		p.add(secretRef, zone, softDelete, now)
+		Expect(p.zones).To(HaveLen(1))
+		for _, z := range p.zones {
+			Expect(z.expiry).To(Equal(now.Add(time.Hour + softDelete.Retention)))
+		}
+	})
+
+	It("should keep zones which could not be purged", func() {
+		clientErr = fmt.Errorf("secret not found")
+		p.add(secretRef, zone, softDelete, now)
+
+		p.purge(ctx, now.Add(softDelete.Retention+purgeInterval+time.Minute))
+		Expect(p.zones).To(HaveLen(1))
+	})
+})
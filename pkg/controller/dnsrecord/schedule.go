@@ -0,0 +1,119 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionTypeChangesScheduled is the type of the DNSRecord condition reporting changes scheduled for the next
+// maintenance window.
+const ConditionTypeChangesScheduled gardencorev1beta1.ConditionType = "ChangesScheduled"
+
+// getChangeWindow returns the maintenance window in which changes of the record set are applied, or nil if changes
+// are applied immediately. The change schedule of the providerConfig takes precedence over the one of the
+// controller configuration.
+func (a *actuator) getChangeWindow(config *infoblox.DNSRecordConfig) (*timewindow.MaintenanceTimeWindow, error) {
+	var begin, end string
+	switch {
+	case config.ChangeSchedule != nil:
+		begin, end = config.ChangeSchedule.Begin, config.ChangeSchedule.End
+	case a.changeSchedule != nil:
+		begin, end = a.changeSchedule.Begin, a.changeSchedule.End
+	default:
+		return nil, nil
+	}
+	window, err := timewindow.ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change schedule: %w", err)
+	}
+	return window, nil
+}
+
+// addScheduledChanges records the changes scheduled for the given time in the given tasks.
+func addScheduledChanges(dns *extensionsv1alpha1.DNSRecord, status *infoblox.DNSRecordStatus, tasks []string, at time.Time) {
+	for _, task := range tasks {
+		addPendingChange(dns, status, task)
+		for i := range status.PendingChanges {
+			if status.PendingChanges[i].Task == task {
+				status.PendingChanges[i].ScheduledTime = &metav1.Time{Time: at}
+			}
+		}
+	}
+}
+
+// scheduledChanges returns the pending changes which are scheduled for a maintenance window.
+func scheduledChanges(changes []infoblox.PendingChange) []infoblox.PendingChange {
+	var scheduled []infoblox.PendingChange
+	for _, change := range changes {
+		if change.ScheduledTime != nil {
+			scheduled = append(scheduled, change)
+		}
+	}
+	return scheduled
+}
+
+// changesScheduledError returns an error requeueing the DNSRecord until the earliest of the given scheduled changes
+// is due.
+func changesScheduledError(changes []infoblox.PendingChange) error {
+	at := earliestScheduledTime(changes)
+	requeueAfter := time.Until(at)
+	if requeueAfter < requeueAfterOnPendingApproval {
+		requeueAfter = requeueAfterOnPendingApproval
+	}
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("DNS recordset changes are scheduled for %s in tasks %s", at.UTC().Format(time.RFC3339), strings.Join(pendingTasks(changes), ", ")),
+		RequeueAfter: requeueAfter,
+	}
+}
+
+func earliestScheduledTime(changes []infoblox.PendingChange) time.Time {
+	var at time.Time
+	for _, change := range changes {
+		if change.ScheduledTime != nil && (at.IsZero() || change.ScheduledTime.Time.Before(at)) {
+			at = change.ScheduledTime.Time
+		}
+	}
+	return at
+}
+
+// setChangesScheduledCondition sets the ChangesScheduled condition according to the given scheduled changes.
+// An existing condition is cleared if there are none.
+func setChangesScheduledCondition(dns *extensionsv1alpha1.DNSRecord, scheduled []infoblox.PendingChange) {
+	var condition gardencorev1beta1.Condition
+	if len(scheduled) > 0 {
+		condition = v1beta1helper.GetOrInitCondition(dns.Status.Conditions, ConditionTypeChangesScheduled)
+		condition = v1beta1helper.UpdatedCondition(condition, gardencorev1beta1.ConditionTrue, "ChangesScheduled",
+			fmt.Sprintf("Changes are scheduled for %s in tasks %s.", earliestScheduledTime(scheduled).UTC().Format(time.RFC3339), strings.Join(pendingTasks(scheduled), ", ")))
+	} else {
+		existing := v1beta1helper.GetCondition(dns.Status.Conditions, ConditionTypeChangesScheduled)
+		if existing == nil || existing.Status == gardencorev1beta1.ConditionFalse {
+			return
+		}
+		condition = v1beta1helper.UpdatedCondition(*existing, gardencorev1beta1.ConditionFalse, "ChangesApplied", "All scheduled changes have been applied.")
+	}
+	dns.Status.Conditions = v1beta1helper.MergeConditions(dns.Status.Conditions, condition)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// scheduledTaskRefPrefix is the prefix of references returned by the WAPI instead of the reference of the changed
+// object if the change has been queued, e.g. for approval.
+const scheduledTaskRefPrefix = "scheduledtask/"
+
+const (
+	// TaskApprovalPending is the approval status of tasks awaiting approval.
+	TaskApprovalPending = "PENDING"
+	// TaskApprovalRejected is the approval status of rejected tasks.
+	TaskApprovalRejected = "REJECTED"
+	// TaskExecutionCompleted is the execution status of tasks which have been applied.
+	TaskExecutionCompleted = "COMPLETED"
+	// TaskExecutionFailed is the execution status of tasks which could not be applied.
+	TaskExecutionFailed = "FAILED"
+)
+
+// PendingApprovalError is returned if a change has been queued for approval instead of being applied.
+type PendingApprovalError struct {
+	// Task is the reference of the scheduled task holding the change.
+	Task string
+}
+
+func (e *PendingApprovalError) Error() string {
+	return fmt.Sprintf("change is awaiting approval in task %s", e.Task)
+}
+
+// PendingApprovalTask returns the task of the change if the given error is or wraps a PendingApprovalError.
+func PendingApprovalTask(err error) (string, bool) {
+	var pending *PendingApprovalError
+	if errors.As(err, &pending) {
+		return pending.Task, true
+	}
+	return "", false
+}
+
+// Task is the state of a scheduled task (scheduledtask) holding a queued change.
+type Task struct {
+	Ref             string `json:"_ref"`
+	TaskID          int    `json:"task_id"`
+	ScheduledTime   int64  `json:"scheduled_time"`
+	ApprovalStatus  string `json:"approval_status"`
+	ExecutionStatus string `json:"execution_status"`
+	Approver        string `json:"approver"`
+	ApproverComment string `json:"approver_comment"`
+}
+
+var taskFields = []string{"task_id", "scheduled_time", "approval_status", "execution_status", "approver", "approver_comment"}
+
+// IsCompleted returns true if the change of the task has been applied.
+func (t *Task) IsCompleted() bool {
+	return t.ExecutionStatus == TaskExecutionCompleted
+}
+
+// IsRejected returns true if the change of the task has been rejected or could not be applied.
+func (t *Task) IsRejected() bool {
+	return t.ApprovalStatus == TaskApprovalRejected || t.ExecutionStatus == TaskExecutionFailed
+}
+
+// Reason returns a description of the state of a rejected or failed task.
+func (t *Task) Reason() string {
+	var b strings.Builder
+	if t.ApprovalStatus == TaskApprovalRejected {
+		b.WriteString("rejected")
+		if t.Approver != "" {
+			b.WriteString(" by " + t.Approver)
+		}
+	} else {
+		b.WriteString("failed")
+	}
+	if t.ApproverComment != "" {
+		b.WriteString(": " + t.ApproverComment)
+	}
+	return b.String()
+}
+
+// GetTask returns the state of the scheduled task with the given reference, or nil if the task does not exist
+// anymore, e.g. because it has been executed and cleaned up.
+func (c *dnsClient) GetTask(ctx context.Context, ref string) (*Task, error) {
+	var task Task
+	if err := c.client.GetObject(raw.NewQueryObject("scheduledtask", taskFields), ref, ibclient.NewQueryParams(false, nil), &task); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot get task %s: %w", ref, err)
+	}
+	return &task, nil
+}
+
+// createObject creates the given object. It returns a PendingApprovalError if the creation has been queued for approval.
+// If changes are scheduled, the creation is submitted as scheduled task.
+func (c *dnsClient) createObject(obj ibclient.IBObject) (string, error) {
+	return c.createObjectAt(obj, c.scheduledAt)
+}
+
+// createObjectAt creates the given object like createObject, but submits it as scheduled task running at the given
+// time unless it is zero.
+func (c *dnsClient) createObjectAt(obj ibclient.IBObject, scheduledAt time.Time) (string, error) {
+	if !scheduledAt.IsZero() {
+		return c.addScheduledTask(c.client.CreateObject(&scheduledObject{IBObject: obj, schedInfo: c.nextSchedInfo(scheduledAt)}))
+	}
+	return c.applied(checkQueued(c.client.CreateObject(obj)))
+}
+
+// updateObject updates the object with the given reference. It returns a PendingApprovalError if the update has been
+// queued for approval. If changes are scheduled, the update is submitted as scheduled task.
+func (c *dnsClient) updateObject(obj ibclient.IBObject, ref string) (string, error) {
+	if !c.scheduledAt.IsZero() {
+		return c.addScheduledTask(c.client.UpdateObject(&scheduledObject{IBObject: obj, schedInfo: c.nextSchedInfo(c.scheduledAt)}, ref))
+	}
+	return c.applied(checkQueued(c.client.UpdateObject(obj, ref)))
+}
+
+// deleteObject deletes the object with the given reference. It returns a PendingApprovalError if the deletion has been
+// queued for approval. If changes are scheduled, the deletion is submitted as scheduled task.
+func (c *dnsClient) deleteObject(ref string) (string, error) {
+	return c.deleteObjectAt(ref, c.scheduledAt)
+}
+
+// deleteObjectAt deletes the object with the given reference like deleteObject, but submits the deletion as scheduled
+// task running at the given time unless it is zero.
+func (c *dnsClient) deleteObjectAt(ref string, scheduledAt time.Time) (string, error) {
+	if !scheduledAt.IsZero() {
+		return c.addScheduledTask(c.deleteObjectScheduled(ref, scheduledAt))
+	}
+	return c.applied(checkQueued(c.client.DeleteObject(ref)))
+}
+
+// applied records that the change has been applied immediately, so that services are restarted if needed.
+func (c *dnsClient) applied(ref string, err error) (string, error) {
+	if err == nil {
+		c.changed = true
+	}
+	return ref, err
+}
+
+func checkQueued(ref string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(ref, scheduledTaskRefPrefix) {
+		return "", &PendingApprovalError{Task: ref}
+	}
+	return ref, nil
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+const (
+	// DefaultOwnerAttribute is the default extensible attribute tagging records as written by the extension.
+	DefaultOwnerAttribute = "Gardener Owner"
+	// RecordOwner is the value of the owner attribute of records written by the extension.
+	RecordOwner = ZoneOwner
+
+	// ConflictPolicyFail fails writing a record set if records not written by the extension have its name and type.
+	ConflictPolicyFail = "Fail"
+	// ConflictPolicyAdopt tags records not written by the extension as owned, so that they are updated.
+	ConflictPolicyAdopt = "Adopt"
+	// ConflictPolicyOverwrite replaces records not written by the extension without tagging them first.
+	ConflictPolicyOverwrite = "Overwrite"
+)
+
+// ConflictError is returned if existing records conflict with a record set to be written.
+type ConflictError struct {
+	// Zone is the zone of the record set.
+	Zone ZoneID
+	// Name is the name of the record set.
+	Name string
+	// RecordType is the type of the record set.
+	RecordType string
+	// Foreign are the values of records with the name and type of the record set not written by the extension.
+	Foreign []string
+	// Types are the types of records with the name of the record set which cannot coexist with it.
+	Types []string
+}
+
+func (e *ConflictError) Error() string {
+	if len(e.Types) > 0 {
+		return fmt.Sprintf("%s records %s in zone %s conflict with existing records of types %s, as CNAME records cannot coexist with other records",
+			e.RecordType, e.Name, e.Zone, strings.Join(e.Types, ", "))
+	}
+	return fmt.Sprintf("%s records %s in zone %s with values %v have not been written by the extension", e.RecordType, e.Name, e.Zone, e.Foreign)
+}
+
+// IsConflict returns true if the given error is or wraps a ConflictError.
+func IsConflict(err error) bool {
+	var conflict *ConflictError
+	return errors.As(err, &conflict)
+}
+
+// anyRecord is a record of any type returned by the allrecords object.
+type anyRecord struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CheckTypeConflicts returns a ConflictError if records of other types with the given name prevent writing a record
+// set of the given type into the zone, i.e. if either the record set or one of the existing records is a CNAME.
+// Records of replacedType written by the extension are no conflict, as they are deleted before the record set is
+// written, e.g. CNAME records replaced by alias records.
+func (c *dnsClient) CheckTypeConflicts(ctx context.Context, zone ZoneID, name, recordType, replacedType string) error {
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return err
+	}
+	relName, ok := raw.RelativeName(wapiName, zone.FQDN)
+	if !ok {
+		return fmt.Errorf("name %s is not in zone %s", name, zone)
+	}
+	if relName == "" {
+		// the zone apex always holds SOA and NS records, and cannot hold CNAME records
+		if recordType == raw.Type_CNAME {
+			return &ConflictError{Zone: zone, Name: wapiName, RecordType: recordType, Types: []string{"SOA", raw.Type_NS}}
+		}
+		return nil
+	}
+
+	search := map[string]string{"zone": zone.FQDN, "name": relName}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var records []anyRecord
+	if err := c.getObjects("allrecords", []string{"name", "type"}, search, &records); err != nil {
+		return fmt.Errorf("cannot list records with name %s in zone %s: %w", wapiName, zone, err)
+	}
+	var types []string
+	for _, r := range records {
+		if !raw.EqualNames(r.Name, relName) {
+			continue
+		}
+		t := recordTypeOf(r.Type)
+		isCNAME := t == raw.Type_CNAME
+		if isCNAME != (recordType == raw.Type_CNAME) && !utils.ValueExists(t, types) {
+			types = append(types, t)
+		}
+	}
+	if replacedType != "" && utils.ValueExists(replacedType, types) {
+		owned, err := c.ownedRecordSet(zone, replacedType, wapiName)
+		if err != nil {
+			return err
+		}
+		if owned {
+			types = removeValue(types, replacedType)
+		}
+	}
+	if len(types) > 0 {
+		return &ConflictError{Zone: zone, Name: wapiName, RecordType: recordType, Types: types}
+	}
+	return nil
+}
+
+// ownedRecordSet returns true if all records with the given name and type in the zone have been written by the
+// extension.
+func (c *dnsClient) ownedRecordSet(zone ZoneID, recordType, wapiName string) (bool, error) {
+	if c.ownerAttribute == "" {
+		return false, nil
+	}
+	records, err := c.searchRecords(zone, recordType, wapiName)
+	if err != nil {
+		return false, fmt.Errorf("cannot list %s records with name %s in zone %s: %w", recordType, wapiName, zone, err)
+	}
+	for _, r := range records {
+		if raw.EqualNames(r.GetDNSName(), wapiName) && !c.owned(r.(raw.Record)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// removeValue returns the given values without the given value.
+func removeValue(values []string, value string) []string {
+	var result []string
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// recordTypeOf returns the record type of the given WAPI object type, e.g. CNAME for record:cname.
+func recordTypeOf(objectType string) string {
+	_, t, ok := strings.Cut(objectType, ":")
+	if !ok {
+		t = objectType
+	}
+	if strings.HasPrefix(t, "host") {
+		return "HOST"
+	}
+	return strings.ToUpper(t)
+}
+
+// recordTag is the body of an update adding extensible attributes to a record.
+type recordTag struct {
+	objectType string
+	AddEa      ibclient.EA `json:"extattrs+"`
+}
+
+func (r *recordTag) ObjectType() string          { return r.objectType }
+func (r *recordTag) ReturnFields() []string      { return nil }
+func (r *recordTag) EaSearch() ibclient.EASearch { return nil }
+
+// AdoptRecords handles the records with the given name and type in the zone which have not been written by the
+// extension according to the given policy: ConflictPolicyFail returns a ConflictError, ConflictPolicyAdopt tags
+// them with the owner attribute, and ConflictPolicyOverwrite leaves them to be replaced. Records are regarded as
+// written by the extension if they are tagged with the owner attribute, soft-deleted, or managed records. It returns the number of
+// adopted records.
+func (c *dnsClient) AdoptRecords(ctx context.Context, zone ZoneID, name, recordType, policy string) (int, error) {
+	if c.ownerAttribute == "" {
+		return 0, fmt.Errorf("records cannot be told apart without owner attribute")
+	}
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return 0, err
+	}
+	if recordType == raw.Type_NS {
+		// record:ns objects have no extensible attributes
+		return 0, nil
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return 0, err
+	}
+	records, err := c.searchRecords(zone, recordType, wapiName)
+	if err != nil {
+		return 0, err
+	}
+
+	var foreign []raw.Record
+	for _, r := range records {
+		if raw.EqualNames(r.GetDNSName(), wapiName) && !c.owned(r.(raw.Record)) {
+			foreign = append(foreign, r.(raw.Record))
+		}
+	}
+	if len(foreign) == 0 {
+		return 0, nil
+	}
+
+	switch policy {
+	case ConflictPolicyOverwrite:
+		// the record set is discovered again, so that the records are replaced
+		c.forgetRecords(zone, recordType, wapiName)
+		return 0, nil
+	case ConflictPolicyAdopt:
+		for i, r := range foreign {
+			obj := &recordTag{objectType: rt.ObjectType, AddEa: ibclient.EA{c.ownerAttribute: RecordOwner}}
+			if _, err := c.updateObject(obj, r.GetId()); err != nil {
+				return i, fmt.Errorf("cannot adopt %s record %s: %w", recordType, r.GetDNSName(), err)
+			}
+		}
+		// the record set is discovered again, so that the adopted records are updated with it
+		c.forgetRecords(zone, recordType, wapiName)
+		return len(foreign), nil
+	default:
+		values := make([]string, 0, len(foreign))
+		for _, r := range foreign {
+			values = append(values, r.GetValue())
+		}
+		return 0, &ConflictError{Zone: zone, Name: wapiName, RecordType: recordType, Foreign: values}
+	}
+}
+
+// owned returns true if the record is tagged as written by the extension, has been soft-deleted by it, or is a
+// managed record.
+func (c *dnsClient) owned(record raw.Record) bool {
+	if c.isKnownRecord(record.GetId()) {
+		return true
+	}
+	ea := record.GetEA()
+	if owner, ok := ea[c.ownerAttribute].(string); ok && c.ownerAttribute != "" && owner == RecordOwner {
+		return true
+	}
+	if c.softDelete != nil {
+		if _, ok := ea[c.softDelete.DeletionAttribute]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var deletionsBlocked = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "infoblox_deletions_blocked_total",
+	Help: "Number of record deletions blocked by the deletion budget, by exceeded limit.",
+}, []string{"limit"})
+
+func init() {
+	metrics.Registry.MustRegister(deletionsBlocked)
+}
+
+// DeletionBudgetExceededError is returned if deleting records would exceed the deletion budget.
+type DeletionBudgetExceededError struct {
+	// Zone is the zone the records would have been deleted from.
+	Zone ZoneID
+	// Deletions is the number of deletions including the blocked ones.
+	Deletions int
+	// Limit is the exceeded limit.
+	Limit int
+	// Window is the time window of the exceeded limit. It is zero if the limit per operation has been exceeded.
+	Window time.Duration
+}
+
+func (e *DeletionBudgetExceededError) Error() string {
+	if e.Window == 0 {
+		return fmt.Sprintf("deleting records in zone %s blocked: %d deletions exceed the limit of %d per operation", e.Zone, e.Deletions, e.Limit)
+	}
+	return fmt.Sprintf("deleting records in zone %s blocked: %d deletions exceed the limit of %d per %s", e.Zone, e.Deletions, e.Limit, e.Window)
+}
+
+// IsDeletionBudgetExceeded returns true if the given error is or wraps a DeletionBudgetExceededError.
+func IsDeletionBudgetExceeded(err error) bool {
+	var exceeded *DeletionBudgetExceededError
+	return errors.As(err, &exceeded)
+}
+
+// DeletionBudget limits the number of records deleted per operation and per time window and zone.
+// A limit of zero disables it.
+type DeletionBudget struct {
+	maxPerOperation int
+	maxPerWindow    int
+	window          time.Duration
+
+	lock sync.Mutex
+	// deletions are the deletions in the window per grid and zone.
+	deletions map[string][]reservedDeletion
+	// lastID is the ID of the last reservation.
+	lastID uint64
+}
+
+// reservedDeletion is a deletion recorded by a reservation.
+type reservedDeletion struct {
+	id   uint64
+	time time.Time
+}
+
+// DeletionReservation identifies the deletions recorded by a call of Reserve, so that they can be released again.
+type DeletionReservation struct {
+	key string
+	id  uint64
+}
+
+// NewDeletionBudget creates a new deletion budget allowing maxPerOperation deletions per operation, and maxPerWindow
+// deletions per zone within the given window.
+func NewDeletionBudget(maxPerOperation, maxPerWindow int, window time.Duration) *DeletionBudget {
+	return &DeletionBudget{
+		maxPerOperation: maxPerOperation,
+		maxPerWindow:    maxPerWindow,
+		window:          window,
+		deletions:       map[string][]reservedDeletion{},
+	}
+}
+
+// Reserve records n deletions in the zone with the given key for an operation which has already deleted done records.
+// If enforce is set, it returns a DeletionBudgetExceededError instead if one of the limits would be exceeded.
+// The returned reservation releases the recorded deletions.
+func (b *DeletionBudget) Reserve(key string, zone ZoneID, done, n int, enforce bool) (DeletionReservation, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	var recent []reservedDeletion
+	for _, d := range b.deletions[key] {
+		if now.Sub(d.time) < b.window {
+			recent = append(recent, d)
+		}
+	}
+	b.deletions[key] = recent
+
+	if enforce {
+		if b.maxPerOperation > 0 && done+n > b.maxPerOperation {
+			deletionsBlocked.WithLabelValues("operation").Inc()
+			return DeletionReservation{}, &DeletionBudgetExceededError{Zone: zone, Deletions: done + n, Limit: b.maxPerOperation}
+		}
+		if b.maxPerWindow > 0 && len(recent)+n > b.maxPerWindow {
+			deletionsBlocked.WithLabelValues("window").Inc()
+			return DeletionReservation{}, &DeletionBudgetExceededError{Zone: zone, Deletions: len(recent) + n, Limit: b.maxPerWindow, Window: b.window}
+		}
+	}
+	b.lastID++
+	for i := 0; i < n; i++ {
+		b.deletions[key] = append(b.deletions[key], reservedDeletion{id: b.lastID, time: now})
+	}
+	return DeletionReservation{key: key, id: b.lastID}, nil
+}
+
+// Release removes n of the deletions recorded by the given reservation, e.g. because they have failed. Deletions of
+// other reservations in the same zone are kept.
+func (b *DeletionBudget) Release(reservation DeletionReservation, n int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var kept []reservedDeletion
+	for _, d := range b.deletions[reservation.key] {
+		if n > 0 && d.id == reservation.id {
+			n--
+			continue
+		}
+		kept = append(kept, d)
+	}
+	b.deletions[reservation.key] = kept
+}
+
+// reserveDeletions reserves the deletion of n records in the given zone in the deletion budget of the client.
+func (c *dnsClient) reserveDeletions(zone ZoneID, n int) (DeletionReservation, error) {
+	if c.deletionBudget == nil || n == 0 {
+		return DeletionReservation{}, nil
+	}
+	reservation, err := c.deletionBudget.Reserve(c.host+"/"+zone.String(), zone, c.deletions, n, !c.deletionsApproved)
+	if err != nil {
+		return DeletionReservation{}, err
+	}
+	c.deletions += n
+	return reservation, nil
+}
+
+// releaseDeletions releases n deletions of the given reservation which have not been carried out.
+func (c *dnsClient) releaseDeletions(reservation DeletionReservation, n int) {
+	if c.deletionBudget == nil || n <= 0 {
+		return
+	}
+	c.deletionBudget.Release(reservation, n)
+	c.deletions -= n
+}
+
+// deleteWithinBudget reserves n deletions in the given zone and carries them out by calling del for each of them.
+// The reservation of the deletions not carried out is released if one of them fails. A deletion queued for approval
+// is regarded as carried out.
+func (c *dnsClient) deleteWithinBudget(zone ZoneID, n int, del func(i int) error) error {
+	reservation, err := c.reserveDeletions(zone, n)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := del(i); err != nil {
+			if _, ok := PendingApprovalTask(err); ok {
+				i++
+			}
+			c.releaseDeletions(reservation, n-i)
+			return err
+		}
+	}
+	return nil
+}
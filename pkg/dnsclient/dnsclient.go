@@ -17,12 +17,12 @@ package dnsclient
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
@@ -33,13 +33,84 @@ import (
 )
 
 type DNSClient interface {
-	GetManagedZones(ctx context.Context) (map[string]string, error)
-	CreateOrUpdateRecordSet(ctx context.Context, view, zone, name, record_type string, values []string, ttl int64) error
-	DeleteRecordSet(ctx context.Context, zone, name, recordType string) error
+	GetManagedZones(ctx context.Context, view string) ([]Zone, error)
+	InvalidateManagedZones(view string)
+	CreateZone(ctx context.Context, zone ZoneID, opts ZoneCreateOptions) (ZoneID, error)
+	DeleteZoneIfEmpty(ctx context.Context, zone ZoneID, ownerAttribute string) (bool, error)
+	ResolveZone(ctx context.Context, zone ZoneID) (ZoneID, error)
+	CheckZoneWritable(ctx context.Context, zone ZoneID) error
+	GetZoneSerial(ctx context.Context, zone ZoneID) (uint32, error)
+	GetTask(ctx context.Context, ref string) (*Task, error)
+	CancelTask(ctx context.Context, ref string) error
+	ScheduledTasks() []string
+	RestartServicesIfNeeded()
+	CheckTypeConflicts(ctx context.Context, zone ZoneID, name, recordType, replacedType string) error
+	AdoptRecords(ctx context.Context, zone ZoneID, name, recordType, policy string) (int, error)
+	UndoRecordSetChange(ctx context.Context, change RecordSetChange) error
+	ManagedRecords() []ManagedRecord
+	PurgeSoftDeletedRecords(ctx context.Context, zone ZoneID) (int, error)
+	CreateOrUpdateRecordSet(ctx context.Context, view string, zone ZoneID, name, record_type string, values []string, ttl int64) error
+	CreateOrUpdateAliasRecordSet(ctx context.Context, view string, zone ZoneID, name, targetType string, targets []string, ttl int64) error
+	CreateOrUpdateNSRecordSet(ctx context.Context, view string, zone ZoneID, name string, nameServers []string, glue map[string][]string) error
+	DeleteRecordSet(ctx context.Context, zone ZoneID, name, recordType string) error
+	CreateOrUpdateHostRecordSet(ctx context.Context, view string, zone ZoneID, name, recordType string, values []string, ttl int64, opts HostOptions) error
+	DeleteHostRecordSet(ctx context.Context, zone ZoneID, name, recordType string) error
+	CreateOrUpdateSharedRecordSet(ctx context.Context, zone ZoneID, name, recordType string, values []string, ttl int64, group string) error
+	DeleteSharedRecordSet(ctx context.Context, zone ZoneID, name, recordType, group string) error
+	GetRecordSetDrift(ctx context.Context, zone ZoneID, desired DesiredRecordSet) (*RecordSetDrift, error)
+	AllocateAddress(ctx context.Context, view string, zone ZoneID, name, recordType string, ttl int64, alloc AllocationOptions, host *HostOptions) (string, error)
 }
 
 type dnsClient struct {
-	client ibclient.IBConnector
+	client   ibclient.IBConnector
+	host     string
+	username string
+	// scheduledAt is the time changes are scheduled for. Changes are applied immediately if it is zero.
+	scheduledAt    time.Time
+	scheduledTasks []string
+	// changed is set if changes have been applied since services have been restarted the last time.
+	changed bool
+	// softDelete configures the soft deletion of record sets. Records are deleted if it is nil.
+	softDelete *SoftDeleteOptions
+	// deletionBudget limits the number of deleted records. Deletions are not limited if it is nil.
+	deletionBudget    *DeletionBudget
+	deletionsApproved bool
+	// deletions is the number of records deleted by this client.
+	deletions int
+	// ownerAttribute is the extensible attribute tagging created records as written by the extension. Records are
+	// not tagged if it is empty.
+	ownerAttribute string
+	// journal persists replacements of records before they are applied. Changes are not journaled if it is nil.
+	journal ChangeJournal
+	// managedRecords are the known records of record sets, which are read by their references.
+	managedRecords []ManagedRecord
+}
+
+// ClientOptions configure how a DNS client changes record sets. They are fixed for the lifetime of the client,
+// which is created for a single reconciliation.
+type ClientOptions struct {
+	// ScheduledAt submits all changes of records as scheduled tasks running at the given time, instead of applying them
+	// immediately. The tasks are chained, so that they run in the order they have been submitted. Changes are applied
+	// immediately if it is zero.
+	ScheduledAt time.Time
+	// SoftDelete makes DeleteRecordSet disable records and tag them with the deletion time instead of deleting them.
+	// Soft-deleted records matching a record set written later are enabled again. Records are deleted if it is nil.
+	SoftDelete *SoftDeleteOptions
+	// DeletionBudget makes the client reserve all deletions of records in the given budget. Deletions are not limited
+	// if it is nil.
+	DeletionBudget *DeletionBudget
+	// DeletionsApproved makes the client only record deletions in the deletion budget, but never block them.
+	DeletionsApproved bool
+	// OwnerAttribute is the extensible attribute the client tags the records it creates with, so that they can be told
+	// apart from records written by others. Records are not tagged if it is empty.
+	OwnerAttribute string
+	// Journal is passed the replacements of records by CreateOrUpdateRecordSet and CreateOrUpdateAliasRecordSet before
+	// records are deleted or created. Changes are not journaled if it is nil, or while changes are scheduled, as
+	// scheduled tasks are run by the grid.
+	Journal ChangeJournal
+	// ManagedRecords make the client update and delete the records of record sets by their references, see
+	// ManagedRecord.
+	ManagedRecords []ManagedRecord
 }
 
 type RecordSet []raw.Base_Record
@@ -77,7 +148,7 @@ func assignDefaultValues(host string) (InfobloxConfig, error) {
 }
 
 // NewDNSClient creates a new dns client based on the Infoblox config provided
-func NewDNSClient(ctx context.Context, username string, password string, host string) (DNSClient, error) {
+func NewDNSClient(ctx context.Context, username string, password string, host string, opts ClientOptions) (DNSClient, error) {
 
 	infobloxConfig, err := assignDefaultValues(host)
 	if err != nil {
@@ -126,14 +197,33 @@ func NewDNSClient(ctx context.Context, username string, password string, host st
 	// todo: set correct type for dns_client to create dns_object
 	// dns_object := ibclient.CreateObject(dns_client.(ibclient.IBObject))
 
+	c := newDNSClient(dns_client, host, opts)
+	c.username = username
+	return c, nil
+}
+
+// NewDNSClientFromConnector creates a new dns client using the given connector for the grid with the given host.
+func NewDNSClientFromConnector(connector ibclient.IBConnector, host string, opts ClientOptions) DNSClient {
+	return newDNSClient(connector, host, opts)
+}
+
+func newDNSClient(connector ibclient.IBConnector, host string, opts ClientOptions) *dnsClient {
 	return &dnsClient{
-		client: dns_client,
-	}, nil
+		client:            connector,
+		host:              host,
+		scheduledAt:       opts.ScheduledAt,
+		softDelete:        opts.SoftDelete,
+		deletionBudget:    opts.DeletionBudget,
+		deletionsApproved: opts.DeletionsApproved,
+		ownerAttribute:    opts.OwnerAttribute,
+		journal:           opts.Journal,
+		managedRecords:    append([]ManagedRecord(nil), opts.ManagedRecords...),
+	}
 }
 
 // get DNS client from secret reference
 // func (c *dnsClient) NewDNSClientFromSecretRef(ctx context.Context, cl client.Client, secretRef corev1.SecretReference) (DNSClient, error) {
-func NewDNSClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (DNSClient, error) {
+func NewDNSClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, opts ClientOptions) (DNSClient, error) {
 	secret, err := extensionscontroller.GetSecretByReference(ctx, c, &secretRef)
 	if err != nil {
 		return nil, err
@@ -155,133 +245,226 @@ func NewDNSClientFromSecretRef(ctx context.Context, c client.Client, secretRef c
 		return nil, fmt.Errorf("no host details found")
 	}
 
-	return NewDNSClient(ctx, string(username), string(password), string(host))
+	return NewDNSClient(ctx, string(username), string(password), string(host), opts)
 
 }
 
-// GetManagedZones returns a map of all managed zone DNS names mapped to their IDs, composed of the project ID and
-// their user assigned resource names.
-func (c *dnsClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
-
-	conn := c.client.(*ibclient.Connector)
+// CreateOrUpdateRecordSet creates or updates the resource recordset with the given name, record type, rrdatas, and ttl
+// in the managed zone with the given name or ID.
+func (c *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, view string, zone ZoneID, name, record_type string, values []string, ttl int64) error {
+	return c.createOrUpdateRecordSet(ctx, zone, record_type, raw.RecordSpec{View: view, Name: name, TTL: ttl}, values)
+}
 
-	rt := ibclient.NewZoneAuth(ibclient.ZoneAuth{})
-	urlStr := conn.RequestBuilder.BuildUrl(ibclient.GET, rt.ObjectType(), "", rt.ReturnFields(), &ibclient.QueryParams{})
+// CreateOrUpdateAliasRecordSet creates or updates the alias records with the given name so that they follow
+// the records of the given target type of the target names.
+func (c *dnsClient) CreateOrUpdateAliasRecordSet(ctx context.Context, view string, zone ZoneID, name, targetType string, targets []string, ttl int64) error {
+	return c.createOrUpdateRecordSet(ctx, zone, raw.Type_ALIAS, raw.RecordSpec{View: view, Name: name, TTL: ttl, TargetType: targetType}, targets)
+}
 
-	req, err := http.NewRequest("GET", urlStr, new(bytes.Buffer))
-	if err != nil {
-		fmt.Println(err)
+// CreateOrUpdateNSRecordSet creates or updates the NS records with the given name delegating it to the given name
+// servers. Name servers within the delegated domain are written with their addresses in glue, others without addresses.
+// CreateOrUpdateNSRecordSet delegates the subdomain with the given name to the given name servers. NS record sets at the
+// zone apex are rejected, as they are maintained by the grid.
+func (c *dnsClient) CreateOrUpdateNSRecordSet(ctx context.Context, view string, zone ZoneID, name string, nameServers []string, glue map[string][]string) error {
+	if isZoneApex(zone, name) {
+		return fmt.Errorf("NS records %s are at the apex of zone %s, only delegations of subdomains are supported", name, zone)
 	}
+	return c.createOrUpdateRecordSet(ctx, zone, raw.Type_NS, raw.RecordSpec{View: view, Name: name, Glue: glue}, nameServers)
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(conn.HostConfig.Username, conn.HostConfig.Password)
+// isZoneApex returns true if the given name is the name of the zone.
+func isZoneApex(zone ZoneID, name string) bool {
+	wapiName, err := raw.ToWAPIName(name)
+	return err == nil && raw.EqualNames(wapiName, zone.FQDN)
+}
 
-	resp, err := conn.Requestor.SendRequest(req)
+func (c *dnsClient) createOrUpdateRecordSet(ctx context.Context, zone ZoneID, recordType string, spec raw.RecordSpec, values []string) error {
+	rt, err := raw.LookupRecordType(recordType)
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
 
-	rs := []ibclient.ZoneAuth{}
-	err = json.Unmarshal(resp, &rs)
-	if err != nil {
-		fmt.Println(err)
+	if spec.Name, err = raw.ToWAPIName(spec.Name); err != nil {
+		return err
 	}
 
-	ZoneList := make(map[string]string)
-
-	for _, zone := range rs {
-		ZoneList[raw.NormalizeHostname(zone.Fqdn)] = zone.Ref
+	// parse and validate all values before touching the existing records
+	parsed := make([]string, 0, len(values))
+	for _, value := range values {
+		v, err := rt.ParseValue(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for record type %s: %w", recordType, err)
+		}
+		valueSpec := spec
+		valueSpec.Value = v
+		if err := rt.Validate(valueSpec); err != nil {
+			return err
+		}
+		parsed = append(parsed, v)
 	}
 
-	return ZoneList, nil
-}
-
-// CreateOrUpdateRecordSet creates or updates the resource recordset with the given name, record type, rrdatas, and ttl
-// in the managed zone with the given name or ID.
-func (c *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, view, zone, name, record_type string, values []string, ttl int64) error {
-
-	records, err := c.GetRecordSet(zone, record_type)
-
+	records, err := c.getRecords(zone, recordType, spec.Name)
+	if err != nil {
+		return err
+	}
+	softDeleted, err := c.getSoftDeletedRecords(zone, recordType, spec.Name)
 	if err != nil {
 		return err
 	}
 
+	// keep existing records equivalent to a desired value, and replace all others
+	kept := make(map[string]bool)
+	var keep, restore, stale []raw.Record
 	for _, r := range records {
-		if r.GetDNSName() == name {
-			err_del := c.DeleteRecord(r.(raw.Record), zone)
-			if err_del != nil {
-				return err_del
+		if !raw.EqualNames(r.GetDNSName(), spec.Name) {
+			continue
+		}
+		key := rt.Normalize(r.GetValue())
+		if containsNormalized(rt, parsed, key) && !kept[key] && rt.Matches(r.(raw.Record), spec) {
+			// a soft-deleted record is restored if it is written again
+			if _, ok := softDeleted[r.GetId()]; ok {
+				restore = append(restore, r.(raw.Record))
 			}
+			keep = append(keep, r.(raw.Record))
+			kept[key] = true
+			continue
+		}
+		stale = append(stale, r.(raw.Record))
+	}
+	var missing []string
+	for _, value := range parsed {
+		if key := rt.Normalize(value); !kept[key] {
+			kept[key] = true
+			missing = append(missing, value)
 		}
 	}
 
-	for _, value := range values {
-		_, err := c.createRecord(name, view, value, ttl, record_type)
+	// a new record set must not shadow shared records with the same name, which is checked before
+	// anything is deleted or restored
+	if len(keep) == 0 && len(missing) > 0 {
+		if err := c.checkNotShared(zone, spec.Name, recordType); err != nil {
+			return err
+		}
+	}
+
+	// the records are discovered again if the change fails
+	c.forgetRecords(zone, recordType, spec.Name)
+	deleted := 0
+	if len(stale) > 0 || len(missing) > 0 {
+		reservation, err := c.reserveDeletions(zone, len(stale))
+		if err != nil {
+			return err
+		}
+		// the deletions are released if the change fails before the stale records have been deleted
+		defer func() { c.releaseDeletions(reservation, len(stale)-deleted) }()
+		// journal the change, so that it can be undone if it is interrupted, e.g. between deletion and creation
+		if err := c.journalChange(ctx, zone, recordType, spec, parsed, records, softDeleted); err != nil {
+			return err
+		}
+	}
+	for _, r := range restore {
+		if err := c.restoreRecord(rt, r); err != nil {
+			return err
+		}
+	}
+	for _, r := range stale {
+		if err := c.DeleteRecord(r, zone); err != nil {
+			if _, ok := PendingApprovalTask(err); ok {
+				deleted++
+			}
+			return err
+		}
+		deleted++
+	}
+
+	refs := make([]string, 0, len(missing))
+	for _, value := range missing {
+		spec.Value = value
+		ref, err := c.createRecord(ctx, rt, spec, c.scheduledAt)
 		if err != nil {
 			return err
 		}
+		refs = append(refs, ref)
 	}
 
-	return err
+	// the references of scheduled creations are tasks, so that the records are discovered once they have run
+	if len(missing) > 0 && !c.scheduledAt.IsZero() {
+		return nil
+	}
+	for _, r := range keep {
+		c.rememberRecord(zone, recordType, r.GetId(), raw.RecordSpec{View: spec.View, Name: spec.Name, Value: r.GetValue(), TTL: spec.TTL})
+	}
+	for i, value := range missing {
+		spec.Value = value
+		c.rememberRecord(zone, recordType, refs[i], spec)
+	}
+	return nil
+}
+
+func containsNormalized(rt *raw.RecordType, values []string, normalized string) bool {
+	for _, v := range values {
+		if rt.Normalize(v) == normalized {
+			return true
+		}
+	}
+	return false
 }
 
 // DeleteRecordSet deletes the resource recordset with the given name and record type
 // in the managed zone with the given name or ID.
-func (c *dnsClient) DeleteRecordSet(ctx context.Context, zone, name, record_type string) error {
+func (c *dnsClient) DeleteRecordSet(ctx context.Context, zone ZoneID, name, record_type string) error {
+	// the NS records of the zone apex are maintained by the grid and have never been written by the client
+	if record_type == raw.Type_NS && isZoneApex(zone, name) {
+		return nil
+	}
 
-	records, err := c.GetRecordSet(zone, record_type)
+	records, err := c.getRecords(zone, record_type, name)
 
+	if err != nil {
+		return err
+	}
+	c.forgetRecords(zone, record_type, name)
+	softDeleted, err := c.getSoftDeletedRecords(zone, record_type, name)
 	if err != nil {
 		return err
 	}
 
+	var deleted []raw.Record
 	for _, rec := range records {
-		if rec.GetId() != "" && rec.GetDNSName() == name {
-			err := c.DeleteRecord(rec.(raw.Record), zone)
-			if err != nil {
-				return err
+		if rec.GetId() != "" && raw.EqualNames(rec.GetDNSName(), name) {
+			// keep the deletion time of records which have already been soft-deleted
+			if _, ok := softDeleted[rec.GetId()]; ok {
+				continue
 			}
+			deleted = append(deleted, rec.(raw.Record))
 		}
 	}
-	return nil
+	// soft deletions count against the deletion budget as well
+	return c.deleteWithinBudget(zone, len(deleted), func(i int) error {
+		if softDeleted != nil {
+			rt, _ := raw.LookupRecordType(record_type)
+			return c.softDeleteRecord(rt, deleted[i])
+		}
+		return c.DeleteRecord(deleted[i], zone)
+	})
 }
 
-// create DNS record for the Infoblox DDI setup
-func (c *dnsClient) createRecord(name string, view string, value string, ttl int64, record_type string) (string, error) {
-
-	var record string
-	var err error
-	var rec ibclient.IBObject
-
-	switch record_type {
-	case raw.Type_A:
-		rec = ibclient.NewRecordA(view, "", name, value, uint32(ttl), false, "", nil, "")
-
-	case raw.Type_AAAA:
-		rec = ibclient.NewRecordAAAA(view, name, value, false, uint32(ttl), "", nil, "")
-
-	case raw.Type_CNAME:
-		rec = ibclient.NewRecordCNAME(view, value, name, true, uint32(ttl), "", nil, "")
-
-	case raw.Type_TXT:
-		rec = ibclient.NewRecordTXT(ibclient.RecordTXT{
-			Name: name,
-			View: view,
-			Text: value,
-		})
+// create DNS record for the Infoblox DDI setup, as scheduled task running at the given time unless it is zero
+func (c *dnsClient) createRecord(ctx context.Context, rt *raw.RecordType, spec raw.RecordSpec, scheduledAt time.Time) (string, error) {
+	if c.ownerAttribute != "" {
+		spec.Ea = ibclient.EA{c.ownerAttribute: RecordOwner}
 	}
-
-	record, err = c.client.CreateObject(rec)
+	rec, err := rt.New(ctx, spec)
 	if err != nil {
 		return "", err
 	}
 
-	return record, nil
+	return c.createObjectAt(rec, scheduledAt)
 }
 
-func (c *dnsClient) DeleteRecord(record raw.Record, zone string) error {
+func (c *dnsClient) DeleteRecord(record raw.Record, zone ZoneID) error {
 
-	_, err := c.client.DeleteObject(record.GetId())
+	_, err := c.deleteObject(record.GetId())
 	if err != nil {
 		return err
 	}
@@ -290,30 +473,28 @@ func (c *dnsClient) DeleteRecord(record raw.Record, zone string) error {
 
 }
 
-func (c *dnsClient) GetRecordSet(zone string, recordType string) (RecordSet, error) {
+// GetRecordSet returns all records of the given type in the given zone. The zone is matched by FQDN and,
+// if known, by view.
+func (c *dnsClient) GetRecordSet(zone ZoneID, recordType string) (RecordSet, error) {
 
 	results := c.client.(*ibclient.Connector)
 
-	if recordType != raw.Type_TXT && recordType != raw.Type_A {
-		return nil, fmt.Errorf("record type %s not supported for GetRecord", recordType)
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, err
 	}
 
-	execRequest := func(forceProxy bool, zone string, recordType string) ([]byte, error) {
+	execRequest := func(forceProxy bool, zone ZoneID) ([]byte, error) {
 
 		record_map := make(map[string]string)
-		record_map["zone"] = zone
-		query_params := ibclient.NewQueryParams(false, record_map)
-
-		var rec ibclient.IBObject
-
-		switch recordType {
-		case "A":
-			rec = ibclient.NewEmptyRecordA()
-		case "TXT":
-			rec = ibclient.NewRecordTXT(ibclient.RecordTXT{})
+		record_map["zone"] = zone.FQDN
+		if zone.View != "" {
+			record_map["view"] = zone.View
 		}
+		query_params := ibclient.NewQueryParams(false, record_map)
 
-		urlStr := results.RequestBuilder.BuildUrl(ibclient.GET, rec.ObjectType(), "", nil, query_params)
+		rec := rt.QueryObject()
+		urlStr := results.RequestBuilder.BuildUrl(ibclient.GET, rec.ObjectType(), "", rec.ReturnFields(), query_params)
 
 		if forceProxy {
 			urlStr += "&_proxy_search=GM"
@@ -328,25 +509,24 @@ func (c *dnsClient) GetRecordSet(zone string, recordType string) (RecordSet, err
 		return results.Requestor.SendRequest(req)
 	}
 
-	resp, err := execRequest(false, zone, recordType)
+	resp, err := execRequest(false, zone)
 	if err != nil {
 		// Forcing the request to redirect to Grid Master by making forcedProxy=true
-		resp, err = execRequest(true, zone, recordType)
+		resp, err = execRequest(true, zone)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	rs := []raw.RecordTXT{}
-	err = json.Unmarshal(resp, &rs)
+	records, err := rt.Decode(resp)
 	if err != nil {
 		return nil, err
 	}
-	rs2 := RecordSet{}
-	for _, r := range rs {
-		rs2 = append(rs2, r.Copy())
+	rs := RecordSet{}
+	for _, r := range records {
+		rs = append(rs, r)
 	}
 
-	return rs2, nil
+	return rs, nil
 
 }
@@ -0,0 +1,197 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// DesiredRecordSet is the record set a DNSRecord is expected to have in a zone.
+type DesiredRecordSet struct {
+	Name       string
+	RecordType string
+	Values     []string
+	TTL        int64
+	// TargetType is the target type of alias records. The record set is written as alias records if it is set.
+	TargetType string
+	// Glue are the addresses of name servers by name, which NS record sets are written with.
+	Glue map[string][]string
+	// Host specifies whether the record set is written as addresses of a host record.
+	Host bool
+	// Shared specifies whether the record set is written as shared records.
+	Shared bool
+	// SharedRecordGroup is the group of the shared records. If it is empty, the records of all groups associated
+	// with the zone are compared.
+	SharedRecordGroup string
+}
+
+// RecordSetDrift describes how a record set in the grid differs from the desired one.
+type RecordSetDrift struct {
+	// Missing are the desired values without enabled record.
+	Missing []string
+	// Unexpected are the values of records which are not desired.
+	Unexpected []string
+	// Mismatched are the desired values of records with other attributes, e.g. another TTL.
+	Mismatched []string
+}
+
+func (d *RecordSetDrift) String() string {
+	var parts []string
+	if len(d.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("missing values %v", d.Missing))
+	}
+	if len(d.Unexpected) > 0 {
+		parts = append(parts, fmt.Sprintf("unexpected values %v", d.Unexpected))
+	}
+	if len(d.Mismatched) > 0 {
+		parts = append(parts, fmt.Sprintf("values with other TTL or target type %v", d.Mismatched))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// actualRecord is a record of a record set in the grid.
+type actualRecord struct {
+	// value is the normalized value of the record.
+	value string
+	// display is the value of the record as reported in the grid.
+	display string
+	// matches is set if the other attributes of the record match the desired ones.
+	matches bool
+}
+
+// GetRecordSetDrift compares the record set in the given zone with the desired one. It returns nil if they match.
+// The records are searched by name. Disabled records, e.g. soft-deleted ones, are regarded as missing.
+func (c *dnsClient) GetRecordSetDrift(ctx context.Context, zone ZoneID, desired DesiredRecordSet) (*RecordSetDrift, error) {
+	recordType := desired.RecordType
+	if desired.TargetType != "" {
+		recordType = raw.Type_ALIAS
+	}
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, err
+	}
+	name, err := raw.ToWAPIName(desired.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual []actualRecord
+	switch {
+	case desired.Host:
+		host, err := c.getHostRecord(zone, name)
+		if err != nil {
+			return nil, err
+		}
+		if host != nil {
+			for _, a := range host.Addresses(recordType) {
+				actual = append(actual, actualRecord{value: rt.Normalize(a.GetValue()), display: a.GetValue(), matches: int64(host.GetTTL()) == desired.TTL})
+			}
+		}
+	case desired.Shared:
+		_, records, err := c.getSharedRecordSet(zone, name, recordType, desired.SharedRecordGroup)
+		if err != nil {
+			return nil, err
+		}
+		for i := range records {
+			actual = append(actual, actualRecord{value: rt.Normalize(records[i].GetValue()), display: records[i].GetValue(), matches: int64(records[i].GetTTL()) == desired.TTL})
+		}
+	default:
+		records, err := c.searchRecords(zone, recordType, name)
+		if err != nil {
+			return nil, err
+		}
+		disabled, err := c.getDisabledRecords(zone, recordType, name)
+		if err != nil {
+			return nil, err
+		}
+		spec := raw.RecordSpec{Name: name, TTL: desired.TTL, TargetType: desired.TargetType, Glue: desired.Glue}
+		for _, r := range records {
+			if !raw.EqualNames(r.GetDNSName(), name) {
+				continue
+			}
+			if disabled[r.GetId()] {
+				continue
+			}
+			actual = append(actual, actualRecord{value: rt.Normalize(r.GetValue()), display: r.GetValue(), matches: rt.Matches(r.(raw.Record), spec)})
+		}
+	}
+	return compareRecordSet(rt, desired.Values, actual)
+}
+
+// compareRecordSet compares the actual records with the desired values. It returns nil if they match.
+func compareRecordSet(rt *raw.RecordType, values []string, actual []actualRecord) (*RecordSetDrift, error) {
+	drift := &RecordSetDrift{}
+	desired := make(map[string]bool, len(values))
+	for _, value := range values {
+		v, err := rt.ParseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for record type %s: %w", rt.Type, err)
+		}
+		key := rt.Normalize(v)
+		if desired[key] {
+			continue
+		}
+		desired[key] = true
+
+		found, matches := false, false
+		for _, a := range actual {
+			if a.value == key {
+				found = true
+				matches = matches || a.matches
+			}
+		}
+		switch {
+		case !found:
+			drift.Missing = append(drift.Missing, value)
+		case !matches:
+			drift.Mismatched = append(drift.Mismatched, value)
+		}
+	}
+	for _, a := range actual {
+		if !desired[a.value] {
+			drift.Unexpected = append(drift.Unexpected, a.display)
+		}
+	}
+	if len(drift.Missing) == 0 && len(drift.Unexpected) == 0 && len(drift.Mismatched) == 0 {
+		return nil, nil
+	}
+	return drift, nil
+}
+
+// LookupZonePolicy returns the policy of the given zone from policies keyed by zone FQDN, optionally qualified with
+// a view. A key qualified with the view of the zone takes precedence over a key without view.
+func LookupZonePolicy(policies map[string]string, zone ZoneID) (string, bool) {
+	var (
+		policy string
+		found  bool
+	)
+	for key, p := range policies {
+		id, err := ParseZoneID(key)
+		if err != nil || id.Ref != "" || !raw.EqualNames(id.FQDN, zone.FQDN) {
+			continue
+		}
+		switch {
+		case id.View != "" && id.View == zone.View:
+			return p, true
+		case id.View == "":
+			policy, found = p, true
+		}
+	}
+	return policy, found
+}
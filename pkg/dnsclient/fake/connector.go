@@ -0,0 +1,250 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fake provides an in-memory WAPI connector for tests.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// Connector is an in-memory WAPI connector. Objects are stored as JSON objects by reference, and searches match
+// the search fields against the fields of the objects.
+type Connector struct {
+	// Objects are the fields of the stored objects by reference.
+	Objects map[string]map[string]interface{}
+	next    int
+	// Queue makes writes return the reference of a scheduled task instead of applying them, like the grid does for
+	// changes which need approval.
+	Queue bool
+	// DeleteErr is returned by deletions if it is set.
+	DeleteErr error
+	// Deleted are the references of the deleted objects.
+	Deleted []string
+	// NextAvailableIP is the address allocated for created objects with a nextavailableip function as address.
+	NextAvailableIP string
+}
+
+var _ ibclient.IBConnector = &Connector{}
+
+// NewConnector returns a Connector without objects.
+func NewConnector() *Connector {
+	return &Connector{Objects: map[string]map[string]interface{}{}}
+}
+
+// Add stores an object of the given type with the given fields and returns its reference.
+func (f *Connector) Add(objectType string, fields map[string]interface{}) string {
+	f.next++
+	ref := fmt.Sprintf("%s/ZG5z%d:%v", objectType, f.next, fields["name"])
+	obj := map[string]interface{}{"_ref": ref}
+	for k, v := range fields {
+		obj[k] = v
+	}
+	f.Objects[ref] = obj
+	return ref
+}
+
+// Get returns the fields of the object with the given reference, or nil if it does not exist.
+func (f *Connector) Get(ref string) map[string]interface{} {
+	return f.Objects[ref]
+}
+
+func (f *Connector) task() string {
+	f.next++
+	return fmt.Sprintf("scheduledtask/b25l%d:%d/PENDING", f.next, f.next)
+}
+
+func (f *Connector) CreateObject(obj ibclient.IBObject) (string, error) {
+	fields, err := toFields(obj)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := fields["_schedinfo"]; ok || f.Queue {
+		return f.task(), nil
+	}
+	for _, field := range []string{"ipv4addr", "ipv6addr"} {
+		if v, ok := fields[field].(string); ok && strings.HasPrefix(v, "func:nextavailableip:") {
+			fields[field] = f.NextAvailableIP
+		}
+	}
+	return f.Add(obj.ObjectType(), fields), nil
+}
+
+func (f *Connector) GetObject(obj ibclient.IBObject, ref string, queryParams *ibclient.QueryParams, res interface{}) error {
+	if ref != "" {
+		o, ok := f.Objects[ref]
+		if !ok {
+			return ibclient.NewNotFoundError("not found")
+		}
+		return fromFields(o, res)
+	}
+	search := searchFields(queryParams)
+	found := []map[string]interface{}{}
+	for r, o := range f.Objects {
+		if obj.ObjectType() == "allrecords" {
+			if o = allRecord(r, o, search["zone"]); o != nil && matches(o, search) {
+				found = append(found, o)
+			}
+		} else if strings.HasPrefix(r, obj.ObjectType()+"/") && matches(o, search) {
+			found = append(found, o)
+		}
+	}
+	if err := fromFields(found, res); err != nil {
+		return err
+	}
+	if len(found) == 0 {
+		return ibclient.NewNotFoundError("not found")
+	}
+	return nil
+}
+
+func (f *Connector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	fields, err := toFields(obj)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := fields["_schedinfo"]; ok || f.Queue {
+		return f.task(), nil
+	}
+	o, ok := f.Objects[ref]
+	if !ok {
+		return "", ibclient.NewNotFoundError("not found")
+	}
+	for k, v := range fields {
+		switch k {
+		case "extattrs+":
+			ea, _ := o["extattrs"].(map[string]interface{})
+			if ea == nil {
+				ea = map[string]interface{}{}
+			}
+			for name, value := range v.(map[string]interface{}) {
+				ea[name] = value
+			}
+			o["extattrs"] = ea
+		case "extattrs-":
+			if ea, ok := o["extattrs"].(map[string]interface{}); ok {
+				for name := range v.(map[string]interface{}) {
+					delete(ea, name)
+				}
+			}
+		default:
+			o[k] = v
+		}
+	}
+	return ref, nil
+}
+
+func (f *Connector) DeleteObject(ref string) (string, error) {
+	if f.DeleteErr != nil {
+		return "", f.DeleteErr
+	}
+	if f.Queue {
+		return f.task(), nil
+	}
+	if _, ok := f.Objects[ref]; !ok {
+		return "", ibclient.NewNotFoundError("not found")
+	}
+	delete(f.Objects, ref)
+	f.Deleted = append(f.Deleted, ref)
+	return ref, nil
+}
+
+// allRecord returns the allrecords object of the record with the given reference, or nil if it is no record of the
+// given zone. Like the grid, it holds the name relative to the zone and the object type of the record.
+func allRecord(ref string, obj map[string]interface{}, zone string) map[string]interface{} {
+	objectType, _, _ := strings.Cut(ref, "/")
+	name, _ := obj["name"].(string)
+	if !strings.HasPrefix(objectType, "record:") || zone == "" {
+		return nil
+	}
+	if name == zone {
+		name = ""
+	} else if !strings.HasSuffix(name, "."+zone) {
+		return nil
+	}
+	result := map[string]interface{}{"_ref": ref, "name": strings.TrimSuffix(name, "."+zone), "type": objectType, "zone": zone}
+	if view, ok := obj["view"]; ok {
+		result["view"] = view
+	}
+	return result
+}
+
+// searchFields returns the search fields of the given query parameters, which are not exported by the connector.
+func searchFields(queryParams *ibclient.QueryParams) map[string]string {
+	search := map[string]string{}
+	if queryParams == nil {
+		return search
+	}
+	fields := reflect.ValueOf(queryParams).Elem().FieldByName("searchFields")
+	iter := fields.MapRange()
+	for iter.Next() {
+		search[iter.Key().String()] = iter.Value().String()
+	}
+	return search
+}
+
+// matches returns true if the object matches all search fields. Extensible attributes are searched by "*<name>" for
+// equal values and by "*<name>~" for regular expressions. Objects without zone field are matched by their name.
+func matches(obj map[string]interface{}, search map[string]string) bool {
+	for key, value := range search {
+		var actual string
+		var ok bool
+		if strings.HasPrefix(key, "*") {
+			name := strings.TrimSuffix(strings.TrimPrefix(key, "*"), "~")
+			ea, _ := obj["extattrs"].(map[string]interface{})
+			attr, _ := ea[name].(map[string]interface{})
+			actual, ok = attr["value"].(string)
+		} else {
+			actual, ok = obj[key].(string)
+			if !ok && key == "zone" {
+				name, _ := obj["name"].(string)
+				actual, ok = value, name == value || strings.HasSuffix(name, "."+value)
+			}
+		}
+		if !ok {
+			return false
+		}
+		if strings.HasSuffix(key, "~") {
+			if !regexp.MustCompile(value).MatchString(actual) {
+				return false
+			}
+		} else if actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func toFields(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	return fields, json.Unmarshal(data, &fields)
+}
+
+func fromFields(fields interface{}, res interface{}) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, res)
+}
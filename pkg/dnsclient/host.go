@@ -0,0 +1,191 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// HostOptions configure how A and AAAA record sets are written as addresses of host records.
+type HostOptions struct {
+	// NetworkView is the network view of new host records. The network view of the DNS view is used if it is empty.
+	NetworkView string
+	// ConfigureForDHCP specifies whether the addresses are configured for DHCP.
+	ConfigureForDHCP bool
+	// MAC is the MAC address of IPv4 addresses configured for DHCP.
+	MAC string
+	// DUID is the DHCP unique identifier of IPv6 addresses configured for DHCP.
+	DUID string
+}
+
+// CreateOrUpdateHostRecordSet writes the values of the A or AAAA record set with the given name as the addresses
+// of the host record with this name. Addresses of the other record type are kept, as both share the host record.
+func (c *dnsClient) CreateOrUpdateHostRecordSet(ctx context.Context, view string, zone ZoneID, name, recordType string, values []string, ttl int64, opts HostOptions) error {
+	rt, err := hostRecordType(recordType)
+	if err != nil {
+		return err
+	}
+	if name, err = raw.ToWAPIName(name); err != nil {
+		return err
+	}
+	if strings.HasPrefix(name, `\052`) {
+		return fmt.Errorf("host records cannot have wildcard names")
+	}
+
+	var addrs []raw.HostAddress
+	seen := make(map[string]bool)
+	for _, value := range values {
+		v, err := rt.ParseValue(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for record type %s: %w", recordType, err)
+		}
+		if key := rt.Normalize(v); !seen[key] {
+			seen[key] = true
+			addrs = append(addrs, newHostAddress(recordType, v, opts))
+		}
+	}
+
+	host, err := c.getHostRecord(zone, name)
+	if err != nil {
+		return err
+	}
+	if host == nil {
+		if len(addrs) == 0 {
+			return nil
+		}
+		if err := c.checkNotShared(zone, name, recordType); err != nil {
+			return err
+		}
+		host = &raw.RecordHost{
+			Name:            name,
+			View:            view,
+			NetworkView:     opts.NetworkView,
+			ConfigureForDNS: true,
+			Ipv4Addrs:       []raw.HostAddress{},
+			Ipv6Addrs:       []raw.HostAddress{},
+		}
+		host.SetAddresses(recordType, addrs)
+		host.SetTTL(int(ttl))
+		_, err := c.createObject(host)
+		return err
+	}
+
+	if host.ConfigureForDNS && host.GetTTL() == int(ttl) && equalHostAddresses(rt, host.Addresses(recordType), addrs) {
+		return nil
+	}
+	host.ConfigureForDNS = true
+	host.SetAddresses(recordType, addrs)
+	host.SetTTL(int(ttl))
+	return c.updateOrDeleteHostRecord(zone, host)
+}
+
+// DeleteHostRecordSet removes the addresses of the given record type from the host record with the given name.
+// The host record is deleted if no addresses are left.
+func (c *dnsClient) DeleteHostRecordSet(ctx context.Context, zone ZoneID, name, recordType string) error {
+	if _, err := hostRecordType(recordType); err != nil {
+		return err
+	}
+	name, err := raw.ToWAPIName(name)
+	if err != nil {
+		return err
+	}
+
+	host, err := c.getHostRecord(zone, name)
+	if err != nil || host == nil {
+		return err
+	}
+	if len(host.Addresses(recordType)) == 0 {
+		return nil
+	}
+	host.SetAddresses(recordType, nil)
+	return c.updateOrDeleteHostRecord(zone, host)
+}
+
+func (c *dnsClient) updateOrDeleteHostRecord(zone ZoneID, host *raw.RecordHost) error {
+	if len(host.Ipv4Addrs) == 0 && len(host.Ipv6Addrs) == 0 {
+		return c.deleteWithinBudget(zone, 1, func(int) error {
+			_, err := c.deleteObject(host.Ref)
+			return err
+		})
+	}
+	_, err := c.updateObject(host.PrepareUpdate(), host.Ref)
+	return err
+}
+
+// getHostRecord returns the host record with the given name in the given zone, or nil if there is none.
+func (c *dnsClient) getHostRecord(zone ZoneID, name string) (*raw.RecordHost, error) {
+	search := map[string]string{"zone": zone.FQDN}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var hosts []raw.RecordHost
+	if err := c.getObjects("record:host", (&raw.RecordHost{}).ReturnFields(), search, &hosts); err != nil {
+		return nil, err
+	}
+	for i := range hosts {
+		if raw.EqualNames(hosts[i].Name, name) {
+			return &hosts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func hostRecordType(recordType string) (*raw.RecordType, error) {
+	if recordType != raw.Type_A && recordType != raw.Type_AAAA {
+		return nil, fmt.Errorf("host records only support record types %s and %s, not %s", raw.Type_A, raw.Type_AAAA, recordType)
+	}
+	return raw.LookupRecordType(recordType)
+}
+
+func newHostAddress(recordType, value string, opts HostOptions) raw.HostAddress {
+	a := raw.HostAddress{ConfigureForDHCP: opts.ConfigureForDHCP}
+	if recordType == raw.Type_AAAA {
+		a.Ipv6Addr = value
+		if opts.ConfigureForDHCP {
+			a.Duid = opts.DUID
+		}
+	} else {
+		a.Ipv4Addr = value
+		if opts.ConfigureForDHCP {
+			a.Mac = opts.MAC
+		}
+	}
+	return a
+}
+
+// equalHostAddresses returns true if both lists contain the same addresses with the same DHCP settings.
+func equalHostAddresses(rt *raw.RecordType, current, desired []raw.HostAddress) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	index := make(map[string]raw.HostAddress, len(current))
+	for _, a := range current {
+		index[rt.Normalize(a.GetValue())] = a
+	}
+	for _, d := range desired {
+		a, ok := index[rt.Normalize(d.GetValue())]
+		if !ok || a.ConfigureForDHCP != d.ConfigureForDHCP {
+			return false
+		}
+		if d.ConfigureForDHCP && (!strings.EqualFold(a.Mac, d.Mac) || !strings.EqualFold(a.Duid, d.Duid)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"time"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// RecordSetChange is a replacement of records of a record set, which is journaled before records are deleted or
+// created, so that it can be undone if it is interrupted.
+type RecordSetChange struct {
+	// Zone is the zone of the record set.
+	Zone ZoneID
+	// View is the DNS view of the records.
+	View string
+	// Name is the name of the record set.
+	Name string
+	// RecordType is the type of the record set.
+	RecordType string
+	// Previous are the records of the record set before the change. Soft-deleted records are not included.
+	Previous []JournaledRecord
+	// Values are the values written by the change.
+	Values []string
+	// TTL is the TTL of the records written by the change.
+	TTL int64
+	// TargetType is the target type of the alias records written by the change.
+	TargetType string
+}
+
+// JournaledRecord is a record of a record set before a change.
+type JournaledRecord struct {
+	Ref        string
+	Value      string
+	TTL        int64
+	TargetType string
+}
+
+// ChangeJournal persists the given change before it is applied. The change is not applied if it returns an error.
+type ChangeJournal func(ctx context.Context, change RecordSetChange) error
+
+// journalChange passes the replacement of the given records by the records of the given values to the journal.
+func (c *dnsClient) journalChange(ctx context.Context, zone ZoneID, recordType string, spec raw.RecordSpec, values []string,
+	records RecordSet, softDeleted map[string]time.Time) error {
+	if c.journal == nil || !c.scheduledAt.IsZero() {
+		return nil
+	}
+	change := RecordSetChange{
+		Zone:       zone,
+		View:       spec.View,
+		Name:       spec.Name,
+		RecordType: recordType,
+		Values:     values,
+		TTL:        spec.TTL,
+		TargetType: spec.TargetType,
+	}
+	for _, r := range records {
+		if !raw.EqualNames(r.GetDNSName(), spec.Name) {
+			continue
+		}
+		if _, ok := softDeleted[r.GetId()]; ok {
+			continue
+		}
+		change.Previous = append(change.Previous, JournaledRecord{
+			Ref:        r.GetId(),
+			Value:      r.GetValue(),
+			TTL:        int64(r.GetTTL()),
+			TargetType: targetTypeOf(r.(raw.Record)),
+		})
+	}
+	return c.journal(ctx, change)
+}
+
+// UndoRecordSetChange restores the records of the record set before the given change, and deletes the records which
+// have been written by it. The records are changed immediately, even if changes are scheduled.
+func (c *dnsClient) UndoRecordSetChange(ctx context.Context, change RecordSetChange) error {
+	rt, err := raw.LookupRecordType(change.RecordType)
+	if err != nil {
+		return err
+	}
+	name, err := raw.ToWAPIName(change.Name)
+	if err != nil {
+		return err
+	}
+	c.forgetRecords(change.Zone, change.RecordType, name)
+
+	records, err := c.getRecords(change.Zone, change.RecordType, name)
+	if err != nil {
+		return err
+	}
+
+	// keep existing records equivalent to a previous record, and delete all others
+	restored := make([]bool, len(change.Previous))
+	var stale []raw.Record
+	for _, r := range records {
+		if !raw.EqualNames(r.GetDNSName(), name) {
+			continue
+		}
+		if i := findJournaledRecord(rt, change.Previous, restored, r.(raw.Record)); i >= 0 {
+			restored[i] = true
+			continue
+		}
+		stale = append(stale, r.(raw.Record))
+	}
+	if err := c.deleteWithinBudget(change.Zone, len(stale), func(i int) error {
+		_, err := c.deleteObjectAt(stale[i].GetId(), time.Time{})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	for i, previous := range change.Previous {
+		if restored[i] {
+			continue
+		}
+		spec := raw.RecordSpec{View: change.View, Name: name, Value: previous.Value, TTL: previous.TTL, TargetType: previous.TargetType}
+		if _, err := c.createRecord(ctx, rt, spec, time.Time{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findJournaledRecord returns the index of the journaled record not restored yet which is equivalent to the given
+// record, or -1 if there is none.
+func findJournaledRecord(rt *raw.RecordType, journaled []JournaledRecord, restored []bool, record raw.Record) int {
+	for i, j := range journaled {
+		if restored[i] || rt.Normalize(j.Value) != rt.Normalize(record.GetValue()) {
+			continue
+		}
+		if rt.Matches(record, raw.RecordSpec{TTL: j.TTL, TargetType: j.TargetType}) {
+			return i
+		}
+	}
+	return -1
+}
+
+// targetTypeOf returns the target type of an alias record, or an empty string for records of other types.
+func targetTypeOf(record raw.Record) string {
+	if alias, ok := record.(*raw.RecordAlias); ok {
+		return alias.TargetType
+	}
+	return ""
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// ManagedRecord is a record written by the client with the attributes last applied to it. The records of a record set
+// are read by the references of its managed records, and records with these references are regarded as written by the
+// client, even if they are not tagged with the owner attribute. A reference not found anymore is stale, e.g. because
+// the record has been deleted by others. It is dropped, and the record set is discovered again by its name.
+type ManagedRecord struct {
+	// Zone is the zone of the record.
+	Zone ZoneID
+	// Ref is the WAPI reference of the record.
+	Ref string
+	// View is the DNS view of the record.
+	View string
+	// Name is the name of the record.
+	Name string
+	// RecordType is the type of the record, ALIAS for alias records.
+	RecordType string
+	// Value is the value last applied to the record.
+	Value string
+	// TTL is the effective TTL of the record.
+	TTL int64
+}
+
+// ManagedRecords returns the records known to the client, i.e. the managed records of its options updated by the record sets
+// written and deleted since. Records written as scheduled tasks are unknown until the record set is discovered again.
+func (c *dnsClient) ManagedRecords() []ManagedRecord {
+	return c.managedRecords
+}
+
+// getRecords returns the records of the given type with the given name in the zone. The records of a record set with
+// managed records are read by their references. Otherwise, or if a reference is stale, the records are searched by
+// name, see searchRecords.
+func (c *dnsClient) getRecords(zone ZoneID, recordType, name string) (RecordSet, error) {
+	rs, ok, err := c.getKnownRecords(zone, recordType, name)
+	if err != nil || ok {
+		return rs, err
+	}
+	return c.searchRecords(zone, recordType, name)
+}
+
+// getKnownRecords reads the managed records of the record set with the given name and type in the zone by their
+// references. It returns false if there are no managed records of the record set, or if a reference is stale, in which
+// case the managed records of the record set are dropped.
+func (c *dnsClient) getKnownRecords(zone ZoneID, recordType, name string) (RecordSet, bool, error) {
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, false, err
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return nil, false, err
+	}
+	var data []json.RawMessage
+	for _, r := range c.managedRecords {
+		if !isRecordOf(r, zone, recordType, wapiName) {
+			continue
+		}
+		var obj json.RawMessage
+		if err := c.client.GetObject(rt.QueryObject(), r.Ref, ibclient.NewQueryParams(false, nil), &obj); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return nil, false, fmt.Errorf("cannot get %s record %s in zone %s: %w", recordType, wapiName, zone, err)
+			}
+			c.forgetRecords(zone, recordType, wapiName)
+			return nil, false, nil
+		}
+		data = append(data, obj)
+	}
+	if len(data) == 0 {
+		return nil, false, nil
+	}
+	list, err := json.Marshal(data)
+	if err != nil {
+		return nil, false, err
+	}
+	records, err := rt.Decode(list)
+	if err != nil {
+		return nil, false, err
+	}
+	rs := RecordSet{}
+	for _, r := range records {
+		rs = append(rs, r)
+	}
+	return rs, true, nil
+}
+
+// searchRecords searches the records of the given type with the given name in the zone, including records not written
+// by the client. They are searched by name instead of listing the records of the zone, and are updated and deleted by
+// their references.
+func (c *dnsClient) searchRecords(zone ZoneID, recordType, name string) (RecordSet, error) {
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, err
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return nil, err
+	}
+	search := map[string]string{"name": wapiName, "zone": zone.FQDN}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var data json.RawMessage
+	if err := c.getObjects(rt.ObjectType, rt.ReturnFields, search, &data); err != nil {
+		return nil, fmt.Errorf("cannot search %s records %s in zone %s: %w", recordType, wapiName, zone, err)
+	}
+	rs := RecordSet{}
+	if len(data) == 0 {
+		return rs, nil
+	}
+	records, err := rt.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// isKnownRecord returns true if the given reference is the reference of a managed record.
+func (c *dnsClient) isKnownRecord(ref string) bool {
+	for _, r := range c.managedRecords {
+		if r.Ref == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// forgetRecords removes the managed records of the record set with the given name and type in the zone, so that the
+// record set is discovered again.
+func (c *dnsClient) forgetRecords(zone ZoneID, recordType, name string) {
+	var res []ManagedRecord
+	for _, r := range c.managedRecords {
+		if !isRecordOf(r, zone, recordType, name) {
+			res = append(res, r)
+		}
+	}
+	c.managedRecords = res
+}
+
+// rememberRecord adds the record with the given reference and the attributes of the given spec to the managed records.
+func (c *dnsClient) rememberRecord(zone ZoneID, recordType, ref string, spec raw.RecordSpec) {
+	c.managedRecords = append(c.managedRecords, ManagedRecord{
+		Zone:       zone,
+		Ref:        ref,
+		View:       spec.View,
+		Name:       spec.Name,
+		RecordType: recordType,
+		Value:      spec.Value,
+		TTL:        spec.TTL,
+	})
+}
+
+func isRecordOf(r ManagedRecord, zone ZoneID, recordType, name string) bool {
+	return r.Zone.String() == zone.String() && r.RecordType == recordType && raw.EqualNames(r.Name, name)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// AllocationOptions configure the allocation of the next available address.
+type AllocationOptions struct {
+	// Network is the network (e.g. 10.0.0.0/24) or range (e.g. 10.0.0.10-10.0.0.50) to allocate the address from.
+	Network string
+	// NetworkView is the network view of the network. The default network view is used if it is empty.
+	NetworkView string
+}
+
+// NextAvailableIP returns the WAPI function value allocating the next available address from the configured
+// network or range.
+func (o AllocationOptions) NextAvailableIP() string {
+	v := "func:nextavailableip:" + o.Network
+	if o.NetworkView != "" {
+		v += "," + o.NetworkView
+	}
+	return v
+}
+
+// AllocateAddress lets the grid allocate the next available address for the A or AAAA record set with the given name,
+// and returns it. The address is written as plain record, or as address of a host record if host is not nil.
+// If the record set already has an address written by the extension, e.g. because an earlier allocation could not be
+// recorded, this address is returned instead of allocating another one. Records are only regarded as written by the
+// extension if they are managed records or tagged with the owner attribute, the addresses of other records are never
+// reused. Soft-deleted records are not regarded either. A PendingApprovalError is returned if the allocation has been
+// queued for approval. Allocations cannot be scheduled, as the address is needed to write the record set.
+func (c *dnsClient) AllocateAddress(ctx context.Context, view string, zone ZoneID, name, recordType string, ttl int64, alloc AllocationOptions, host *HostOptions) (string, error) {
+	if recordType != raw.Type_A && recordType != raw.Type_AAAA {
+		return "", fmt.Errorf("addresses can only be allocated for record types %s and %s, not %s", raw.Type_A, raw.Type_AAAA, recordType)
+	}
+	if alloc.Network == "" {
+		return "", fmt.Errorf("no network to allocate the address from")
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return "", err
+	}
+
+	if host != nil {
+		return c.allocateHostAddress(ctx, view, zone, wapiName, recordType, ttl, alloc, *host)
+	}
+
+	records, err := c.getRecords(zone, recordType, wapiName)
+	if err != nil {
+		return "", err
+	}
+	softDeleted, err := c.getSoftDeletedRecords(zone, recordType, wapiName)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range records {
+		if _, ok := softDeleted[r.GetId()]; ok {
+			continue
+		}
+		if !c.owned(r.(raw.Record)) {
+			continue
+		}
+		return r.GetValue(), nil
+	}
+
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return "", err
+	}
+	ref, err := c.createRecord(ctx, rt, raw.RecordSpec{View: view, Name: wapiName, TTL: ttl, Value: alloc.NextAvailableIP()}, c.scheduledAt)
+	if err != nil {
+		return "", fmt.Errorf("cannot allocate address from %s: %w", alloc.Network, err)
+	}
+
+	var res map[string]interface{}
+	if err := c.client.GetObject(rt.QueryObject(), ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return "", fmt.Errorf("cannot read allocated address of %s: %w", ref, err)
+	}
+	field := "ipv4addr"
+	if recordType == raw.Type_AAAA {
+		field = "ipv6addr"
+	}
+	addr, _ := res[field].(string)
+	if addr == "" {
+		return "", fmt.Errorf("no address allocated for %s", ref)
+	}
+	return addr, nil
+}
+
+func (c *dnsClient) allocateHostAddress(ctx context.Context, view string, zone ZoneID, name, recordType string, ttl int64, alloc AllocationOptions, opts HostOptions) (string, error) {
+	if opts.NetworkView == "" {
+		opts.NetworkView = alloc.NetworkView
+	}
+	addrs := []raw.HostAddress{newHostAddress(recordType, alloc.NextAvailableIP(), opts)}
+
+	host, err := c.getHostRecord(zone, name)
+	if err != nil {
+		return "", err
+	}
+
+	var ref string
+	switch {
+	case host != nil && len(host.Addresses(recordType)) > 0:
+		return host.Addresses(recordType)[0].GetValue(), nil
+	case host != nil:
+		host.SetAddresses(recordType, addrs)
+		ref, err = c.updateObject(host.PrepareUpdate(), host.Ref)
+	default:
+		host = &raw.RecordHost{
+			Name:            name,
+			View:            view,
+			NetworkView:     opts.NetworkView,
+			ConfigureForDNS: true,
+			Ipv4Addrs:       []raw.HostAddress{},
+			Ipv6Addrs:       []raw.HostAddress{},
+		}
+		host.SetAddresses(recordType, addrs)
+		host.SetTTL(int(ttl))
+		ref, err = c.createObject(host)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot allocate address from %s: %w", alloc.Network, err)
+	}
+
+	var res raw.RecordHost
+	if err := c.client.GetObject(&raw.RecordHost{}, ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return "", fmt.Errorf("cannot read allocated address of %s: %w", ref, err)
+	}
+	if len(res.Addresses(recordType)) == 0 {
+		return "", fmt.Errorf("no address allocated for %s", ref)
+	}
+	return res.Addresses(recordType)[0].GetValue(), nil
+}
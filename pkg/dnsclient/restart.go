@@ -0,0 +1,314 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+const (
+	// DefaultServiceRestartMinInterval is the default minimum time between two restarts of the DNS services of a grid.
+	DefaultServiceRestartMinInterval = 10 * time.Minute
+	// DefaultServiceRestartMaxRetries is the default number of retries of a failed restart of the DNS services.
+	DefaultServiceRestartMaxRetries = 5
+)
+
+var (
+	serviceRestarts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "infoblox_service_restarts_total",
+		Help: "Number of DNS service restarts requested from grids.",
+	})
+	serviceRestartFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "infoblox_service_restart_failures_total",
+		Help: "Number of DNS service restarts which could not be requested from grids.",
+	})
+	serviceRestartsCoalesced = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "infoblox_service_restarts_coalesced_total",
+		Help: "Number of DNS service restarts merged into a restart already waiting for the minimum interval.",
+	})
+
+	// defaultServiceRestarter is nil as long as service restarts are not enabled.
+	defaultServiceRestarter *ServiceRestarter
+	restarterLock           sync.Mutex
+
+	restartLogger = log.Log.WithName("infoblox-service-restarter")
+)
+
+func init() {
+	metrics.Registry.MustRegister(serviceRestarts, serviceRestartFailures, serviceRestartsCoalesced)
+}
+
+// EnableServiceRestarts enables restarts of the DNS services of a grid after changes, with at most one restart per
+// grid within the given minimum interval. The returned restarter must be started, e.g. by adding it to the manager.
+func EnableServiceRestarts(minInterval time.Duration) *ServiceRestarter {
+	restarterLock.Lock()
+	defer restarterLock.Unlock()
+	defaultServiceRestarter = NewServiceRestarter(clock.RealClock{}, minInterval, DefaultServiceRestartMaxRetries)
+	return defaultServiceRestarter
+}
+
+func getServiceRestarter() *ServiceRestarter {
+	restarterLock.Lock()
+	defer restarterLock.Unlock()
+	return defaultServiceRestarter
+}
+
+// RestartServicesIfNeeded requests a restart of the DNS services of the grid if this client has applied changes
+// since the last call and service restarts are enabled. The restart is performed asynchronously and coalesced with
+// the restarts requested by other clients for the same grid. The grid only restarts services which need it.
+func (c *dnsClient) RestartServicesIfNeeded() {
+	changed := c.changed
+	c.changed = false
+	restarter := getServiceRestarter()
+	if !changed || restarter == nil {
+		return
+	}
+	restarter.Request(c.host, c.restartServices)
+}
+
+// restartServices lets the grid determine which members need a restart of their DNS service, and restarts them.
+func (c *dnsClient) restartServices(ctx context.Context) error {
+	var grids []struct {
+		Ref string `json:"_ref"`
+	}
+	if err := c.client.GetObject(raw.NewQueryObject("grid", nil), "", ibclient.NewQueryParams(false, nil), &grids); err != nil {
+		return fmt.Errorf("cannot get grid: %w", err)
+	}
+	if len(grids) == 0 {
+		return fmt.Errorf("cannot get grid: no grid object found")
+	}
+	ref := grids[0].Ref
+	if err := c.callFunction(ctx, ref, "requestrestartservicestatus", map[string]interface{}{"service_option": "DNS"}); err != nil {
+		return err
+	}
+	return c.callFunction(ctx, ref, "restartservices", map[string]interface{}{
+		"restart_option": "RESTART_IF_NEEDED",
+		"services":       []string{"DNS"},
+		"mode":           "GROUPED",
+	})
+}
+
+// callFunction calls the WAPI function with the given name and arguments on the object with the given reference.
+// The connector does not support function calls.
+func (c *dnsClient) callFunction(ctx context.Context, ref, function string, args interface{}) error {
+	conn, ok := c.client.(*ibclient.Connector)
+	if !ok {
+		return fmt.Errorf("function calls are not supported by the connector")
+	}
+	body, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	urlStr := conn.RequestBuilder.BuildUrl(ibclient.CREATE, "", ref, nil, nil) + "?_function=" + function
+	if _, err := c.sendRequest(ctx, conn, http.MethodPost, urlStr, body); err != nil {
+		return fmt.Errorf("cannot call function %s of %s: %w", function, ref, err)
+	}
+	return nil
+}
+
+// sendRequest sends a request with the credentials of the given connector and returns the body of the response.
+func (c *dnsClient) sendRequest(ctx context.Context, conn *ibclient.Connector, method, urlStr string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(conn.HostConfig.Username, conn.HostConfig.Password)
+	return conn.Requestor.SendRequest(req)
+}
+
+// ServiceRestarter coalesces the restarts of the DNS services per grid, so that at most one restart is performed
+// within the minimum interval. Restarts requested within the interval are merged into one restart at its end.
+// Restarts are performed by Start, which implements manager.Runnable.
+type ServiceRestarter struct {
+	clock       clock.Clock
+	minInterval time.Duration
+	maxRetries  int
+	// wakeup is signalled when a restart has been requested.
+	wakeup chan struct{}
+
+	lock  sync.Mutex
+	grids map[string]*gridRestart
+}
+
+type gridRestart struct {
+	// last is the time of the last restart.
+	last time.Time
+	// due is the time the pending restart is performed at.
+	due time.Time
+	// failures is the number of failed attempts of the pending restart.
+	failures int
+	// restart is the function performing the next restart. It is nil if no restart is pending.
+	restart func(ctx context.Context) error
+}
+
+// NewServiceRestarter creates a new service restarter with the given minimum interval between two restarts of a grid.
+// Failed restarts are retried with an exponential backoff starting at the minimum interval, at most maxRetries times.
+func NewServiceRestarter(clock clock.Clock, minInterval time.Duration, maxRetries int) *ServiceRestarter {
+	return &ServiceRestarter{
+		clock:       clock,
+		minInterval: minInterval,
+		maxRetries:  maxRetries,
+		wakeup:      make(chan struct{}, 1),
+		grids:       map[string]*gridRestart{},
+	}
+}
+
+// Request requests a restart of the grid with the given key by the given function. The restart is performed as soon
+// as the minimum interval since the last restart of the grid has passed. If a restart of the grid is already pending,
+// the requests are merged and only the latest function is called.
+func (r *ServiceRestarter) Request(key string, restart func(ctx context.Context) error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	g := r.grids[key]
+	if g == nil {
+		g = &gridRestart{}
+		r.grids[key] = g
+	}
+	if g.restart != nil {
+		serviceRestartsCoalesced.Inc()
+		g.restart = restart
+		return
+	}
+	g.restart = restart
+	g.due = r.clock.Now()
+	if !g.last.IsZero() && g.last.Add(r.minInterval).After(g.due) {
+		g.due = g.last.Add(r.minInterval)
+	}
+	select {
+	case r.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Start performs the requested restarts until the context is cancelled. It implements manager.Runnable.
+func (r *ServiceRestarter) Start(ctx context.Context) error {
+	for {
+		r.runDue(ctx)
+
+		var timer clock.Timer
+		var fire <-chan time.Time
+		if due, ok := r.nextDue(); ok {
+			timer = r.clock.NewTimer(due.Sub(r.clock.Now()))
+			fire = timer.C()
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil
+		case <-r.wakeup:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-fire:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Restarts are requested by the reconciliations of the
+// leader, so that they are performed by the leader as well.
+func (r *ServiceRestarter) NeedLeaderElection() bool {
+	return true
+}
+
+// nextDue returns the earliest time a pending restart is due at, if any.
+func (r *ServiceRestarter) nextDue() (time.Time, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var next time.Time
+	found := false
+	for _, g := range r.grids {
+		if g.restart != nil && (!found || g.due.Before(next)) {
+			next, found = g.due, true
+		}
+	}
+	return next, found
+}
+
+// runDue performs the pending restarts which are due.
+func (r *ServiceRestarter) runDue(ctx context.Context) {
+	now := r.clock.Now()
+	r.lock.Lock()
+	var keys []string
+	for key, g := range r.grids {
+		if g.restart != nil && !g.due.After(now) {
+			keys = append(keys, key)
+		}
+	}
+	r.lock.Unlock()
+
+	for _, key := range keys {
+		r.run(ctx, key)
+	}
+}
+
+// run performs the pending restart of the grid with the given key. A failed restart is retried with backoff unless
+// a newer request has replaced it meanwhile, and dropped after the maximum number of retries.
+func (r *ServiceRestarter) run(ctx context.Context, key string) {
+	r.lock.Lock()
+	g := r.grids[key]
+	restart := g.restart
+	g.restart = nil
+	g.last = r.clock.Now()
+	r.lock.Unlock()
+
+	if restart == nil {
+		return
+	}
+	err := restart(ctx)
+	if err == nil {
+		serviceRestarts.Inc()
+		r.lock.Lock()
+		g.failures = 0
+		r.lock.Unlock()
+		restartLogger.Info("Requested restart of DNS services", "grid", key)
+		return
+	}
+
+	serviceRestartFailures.Inc()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if g.restart != nil {
+		// A newer request is already pending
+		return
+	}
+	g.failures++
+	if g.failures > r.maxRetries {
+		restartLogger.Error(err, "Restart of DNS services failed, giving up", "grid", key, "attempts", g.failures)
+		g.failures = 0
+		return
+	}
+	backoff := r.minInterval << (g.failures - 1)
+	restartLogger.Error(err, "Restart of DNS services failed, retrying", "grid", key, "after", backoff)
+	g.restart = restart
+	g.due = g.last.Add(backoff)
+}
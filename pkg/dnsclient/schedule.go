@@ -0,0 +1,134 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// NextChangeTime returns the time changes may be applied at according to the given window. It returns the zero
+// time if now is within the window, so that changes are applied immediately, and the next begin of the window otherwise.
+func NextChangeTime(window *timewindow.MaintenanceTimeWindow, now time.Time) time.Time {
+	if window == nil || window.Contains(now) {
+		return time.Time{}
+	}
+	now = now.UTC()
+	b := window.Begin()
+	begin := time.Date(now.Year(), now.Month(), now.Day(), b.Hour(), b.Minute(), b.Second(), 0, time.UTC)
+	if begin.Before(now) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	return begin
+}
+
+// ScheduledTasks returns the tasks of the changes scheduled since the last call.
+func (c *dnsClient) ScheduledTasks() []string {
+	tasks := c.scheduledTasks
+	c.scheduledTasks = nil
+	return tasks
+}
+
+// CancelTask deletes the scheduled task with the given reference, so that its change is not applied.
+// Tasks which do not exist anymore are ignored.
+func (c *dnsClient) CancelTask(ctx context.Context, ref string) error {
+	if _, err := c.client.DeleteObject(ref); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return fmt.Errorf("cannot cancel task %s: %w", ref, err)
+		}
+	}
+	return nil
+}
+
+// schedInfo is the _schedinfo field of a scheduled change.
+type schedInfo struct {
+	ScheduledTime   int64  `json:"scheduled_time"`
+	PredecessorTask string `json:"predecessor_task,omitempty"`
+}
+
+func (c *dnsClient) nextSchedInfo(scheduledAt time.Time) schedInfo {
+	info := schedInfo{ScheduledTime: scheduledAt.Unix()}
+	if len(c.scheduledTasks) > 0 {
+		info.PredecessorTask = c.scheduledTasks[len(c.scheduledTasks)-1]
+	}
+	return info
+}
+
+// addScheduledTask records the task returned for a scheduled change.
+func (c *dnsClient) addScheduledTask(ref string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(ref, scheduledTaskRefPrefix) {
+		return ref, nil
+	}
+	c.scheduledTasks = append(c.scheduledTasks, ref)
+	return "", nil
+}
+
+// scheduledObject adds the _schedinfo field to the body of a WAPI object.
+type scheduledObject struct {
+	ibclient.IBObject
+	schedInfo schedInfo
+}
+
+func (o *scheduledObject) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(o.IBObject)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if fields["_schedinfo"], err = json.Marshal(o.schedInfo); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// deleteObjectScheduled deletes the object with the given reference at the given time. The WAPI expects the
+// _schedinfo of deletions as query parameters, which the connector does not support for DELETE requests.
+func (c *dnsClient) deleteObjectScheduled(ref string, scheduledAt time.Time) (string, error) {
+	conn, ok := c.client.(*ibclient.Connector)
+	if !ok {
+		return "", fmt.Errorf("scheduled deletions are not supported by the connector")
+	}
+	info := c.nextSchedInfo(scheduledAt)
+	query := url.Values{"_schedinfo.scheduled_time": []string{strconv.FormatInt(info.ScheduledTime, 10)}}
+	if info.PredecessorTask != "" {
+		query.Set("_schedinfo.predecessor_task", info.PredecessorTask)
+	}
+	urlStr := conn.RequestBuilder.BuildUrl(ibclient.DELETE, "", ref, nil, nil) + "?" + query.Encode()
+
+	resp, err := c.sendRequest(context.Background(), conn, http.MethodDelete, urlStr, nil)
+	if err != nil {
+		return "", err
+	}
+	var res string
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return "", fmt.Errorf("cannot unmarshal response of scheduled deletion of %s: %w", ref, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// CreateOrUpdateSharedRecordSet writes the record set with the given name as shared records of a shared record
+// group associated with the zone, so that it appears in all zones of the group. If group is empty, the group
+// already holding shared records with this name and type is used.
+func (c *dnsClient) CreateOrUpdateSharedRecordSet(ctx context.Context, zone ZoneID, name, recordType string, values []string, ttl int64, group string) error {
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return err
+	}
+	relName, records, err := c.getSharedRecordSet(zone, name, recordType, group)
+	if err != nil {
+		return err
+	}
+	if group == "" {
+		if group, err = sharedRecordGroupOf(records); err != nil {
+			return err
+		}
+		if group == "" {
+			return fmt.Errorf("name %s in zone %s is not backed by a shared record group, the group must be specified", name, zone)
+		}
+	}
+
+	parsed := make([]string, 0, len(values))
+	for _, value := range values {
+		v, err := rt.ParseValue(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for record type %s: %w", recordType, err)
+		}
+		parsed = append(parsed, v)
+	}
+
+	// keep existing shared records equivalent to a desired value, and replace all others
+	kept := make(map[string]bool)
+	var stale []raw.SharedRecord
+	for _, r := range records {
+		key := rt.Normalize(r.GetValue())
+		if containsNormalized(rt, parsed, key) && !kept[key] && r.GetTTL() == int(ttl) {
+			kept[key] = true
+			continue
+		}
+		stale = append(stale, r)
+	}
+	if err := c.deleteWithinBudget(zone, len(stale), func(i int) error {
+		if _, err := c.deleteObject(stale[i].Ref); err != nil {
+			return fmt.Errorf("cannot delete shared record %s: %w", stale[i].Ref, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, value := range parsed {
+		key := rt.Normalize(value)
+		if kept[key] {
+			continue
+		}
+		kept[key] = true
+		r, err := raw.NewSharedRecord(recordType, group, relName, value, ttl)
+		if err != nil {
+			return err
+		}
+		if _, err := c.createObject(r); err != nil {
+			return fmt.Errorf("cannot create shared record in group %s: %w", group, err)
+		}
+	}
+	return nil
+}
+
+// DeleteSharedRecordSet deletes the shared records with the given name and record type of the shared record groups
+// associated with the zone, or of the given group only if it is not empty. The records disappear from all zones of the group.
+func (c *dnsClient) DeleteSharedRecordSet(ctx context.Context, zone ZoneID, name, recordType, group string) error {
+	_, records, err := c.getSharedRecordSet(zone, name, recordType, group)
+	if err != nil {
+		return err
+	}
+	return c.deleteWithinBudget(zone, len(records), func(i int) error {
+		if _, err := c.deleteObject(records[i].Ref); err != nil {
+			return fmt.Errorf("cannot delete shared record %s: %w", records[i].Ref, err)
+		}
+		return nil
+	})
+}
+
+// getSharedRecordSet returns the relative name and the shared records with this name and the given type of the
+// shared record groups associated with the zone. If group is not empty, it must be associated with the zone, and
+// only its records are returned.
+func (c *dnsClient) getSharedRecordSet(zone ZoneID, name, recordType, group string) (string, []raw.SharedRecord, error) {
+	objectType, err := raw.SharedRecordObjectType(recordType)
+	if err != nil {
+		return "", nil, err
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return "", nil, err
+	}
+	relName, ok := raw.RelativeName(wapiName, zone.FQDN)
+	if !ok {
+		return "", nil, fmt.Errorf("name %s is not in zone %s", name, zone)
+	}
+
+	groups, err := c.getSharedRecordGroups(zone)
+	if err != nil {
+		return "", nil, err
+	}
+	if group != "" {
+		if !containsGroup(groups, group) {
+			return "", nil, fmt.Errorf("shared record group %s is not associated with zone %s", group, zone)
+		}
+		groups = []string{group}
+	}
+
+	var res []raw.SharedRecord
+	for _, g := range groups {
+		var records []raw.SharedRecord
+		query := &raw.SharedRecord{Type: recordType}
+		if err := c.getObjects(objectType, query.ReturnFields(), map[string]string{"shared_record_group": g}, &records); err != nil {
+			return "", nil, fmt.Errorf("cannot list shared records of group %s: %w", g, err)
+		}
+		for _, r := range records {
+			if raw.NormalizeName(r.Name) == relName {
+				r.Type = recordType
+				res = append(res, r)
+			}
+		}
+	}
+	return relName, res, nil
+}
+
+// getSharedRecordGroups returns the names of the shared record groups associated with the zone.
+func (c *dnsClient) getSharedRecordGroups(zone ZoneID) ([]string, error) {
+	var groups []raw.SharedRecordGroup
+	if err := c.getObjects("sharedrecordgroup", (&raw.SharedRecordGroup{}).ReturnFields(), nil, &groups); err != nil {
+		return nil, fmt.Errorf("cannot list shared record groups: %w", err)
+	}
+	var names []string
+	for _, g := range groups {
+		if g.IsAssociatedWith(zone.FQDN, zone.View) {
+			names = append(names, g.Name)
+		}
+	}
+	return names, nil
+}
+
+// checkNotShared returns an error if the name is backed by shared records of the given type, which a regular
+// record with the same name would shadow.
+func (c *dnsClient) checkNotShared(zone ZoneID, name, recordType string) error {
+	if _, err := raw.SharedRecordObjectType(recordType); err != nil {
+		return nil
+	}
+	_, records, err := c.getSharedRecordSet(zone, name, recordType, "")
+	if err != nil {
+		return err
+	}
+	if len(records) > 0 {
+		return fmt.Errorf("name %s in zone %s is backed by shared record group %s, refusing to create a %s record shadowing it",
+			name, zone, records[0].SharedRecordGroup, recordType)
+	}
+	return nil
+}
+
+// sharedRecordGroupOf returns the group of the given shared records, or an empty string if there are none.
+func sharedRecordGroupOf(records []raw.SharedRecord) (string, error) {
+	var groups []string
+	for _, r := range records {
+		if !containsGroup(groups, r.SharedRecordGroup) {
+			groups = append(groups, r.SharedRecordGroup)
+		}
+	}
+	if len(groups) > 1 {
+		return "", fmt.Errorf("shared records are spread across the groups %s, the group must be specified", strings.Join(groups, ", "))
+	}
+	if len(groups) == 0 {
+		return "", nil
+	}
+	return groups[0], nil
+}
+
+func containsGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
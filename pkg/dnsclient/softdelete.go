@@ -0,0 +1,237 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+const (
+	// DefaultDeletionAttribute is the default extensible attribute holding the time a record has been soft-deleted.
+	DefaultDeletionAttribute = "Gardener Deletion Timestamp"
+	// DefaultSoftDeleteRetention is the default time soft-deleted records are kept before they are purged.
+	DefaultSoftDeleteRetention = 7 * 24 * time.Hour
+
+	// purgeInterval is the minimum time between two purges of the soft-deleted records of a zone.
+	purgeInterval = time.Hour
+)
+
+// softDeletableTypes are the record types which can be disabled. Records of other types are always deleted.
+var softDeletableTypes = []string{raw.Type_A, raw.Type_AAAA, raw.Type_CNAME, raw.Type_TXT, raw.Type_ALIAS}
+
+// SoftDeleteOptions configures the soft deletion of record sets.
+type SoftDeleteOptions struct {
+	// DeletionAttribute is the extensible attribute holding the time a record has been soft-deleted.
+	DeletionAttribute string
+	// Retention is the time soft-deleted records are kept before they are purged.
+	Retention time.Duration
+}
+
+// recordState holds the fields of a record relevant for soft deletion.
+type recordState struct {
+	Ref     string      `json:"_ref"`
+	Name    string      `json:"name"`
+	Disable bool        `json:"disable"`
+	Ea      ibclient.EA `json:"extattrs"`
+}
+
+var recordStateFields = []string{"name", "disable", "extattrs"}
+
+// deletedAt returns the time the record has been soft-deleted at, or false if it is not soft-deleted.
+func (s *recordState) deletedAt(attribute string) (time.Time, bool) {
+	if !s.Disable {
+		return time.Time{}, false
+	}
+	value, ok := s.Ea[attribute].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// recordDisable is the body of an update disabling or enabling a record.
+type recordDisable struct {
+	objectType string
+	Disable    bool                `json:"disable"`
+	AddEa      ibclient.EA         `json:"extattrs+,omitempty"`
+	RemoveEa   map[string]struct{} `json:"extattrs-,omitempty"`
+}
+
+func (r *recordDisable) ObjectType() string          { return r.objectType }
+func (r *recordDisable) ReturnFields() []string      { return nil }
+func (r *recordDisable) EaSearch() ibclient.EASearch { return nil }
+
+// getSoftDeletedRecords returns the times the soft-deleted records of the given type in the given zone have been
+// deleted at by their references. Only records with the given name are returned if it is not empty. The records are
+// searched by the deletion attribute, so that the other records of the zone are not listed.
+func (c *dnsClient) getSoftDeletedRecords(zone ZoneID, recordType, name string) (map[string]time.Time, error) {
+	if c.softDelete == nil || !utils.ValueExists(recordType, softDeletableTypes) {
+		return nil, nil
+	}
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, err
+	}
+	// records tagged with any deletion time
+	search := map[string]string{"zone": zone.FQDN, "*" + c.softDelete.DeletionAttribute + "~": "."}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	if name != "" {
+		if name, err = raw.ToWAPIName(name); err != nil {
+			return nil, err
+		}
+		search["name"] = name
+	}
+	var states []recordState
+	if err := c.getObjects(rt.ObjectType, recordStateFields, search, &states); err != nil {
+		return nil, fmt.Errorf("cannot get state of %s records in zone %s: %w", recordType, zone, err)
+	}
+	deleted := map[string]time.Time{}
+	for i := range states {
+		if name != "" && !raw.EqualNames(states[i].Name, name) {
+			continue
+		}
+		if t, ok := states[i].deletedAt(c.softDelete.DeletionAttribute); ok {
+			deleted[states[i].Ref] = t
+		}
+	}
+	return deleted, nil
+}
+
+// getDisabledRecords returns the references of the disabled records of the given type with the given name in the zone,
+// including soft-deleted records.
+func (c *dnsClient) getDisabledRecords(zone ZoneID, recordType, name string) (map[string]bool, error) {
+	if !utils.ValueExists(recordType, softDeletableTypes) {
+		return nil, nil
+	}
+	rt, err := raw.LookupRecordType(recordType)
+	if err != nil {
+		return nil, err
+	}
+	wapiName, err := raw.ToWAPIName(name)
+	if err != nil {
+		return nil, err
+	}
+	search := map[string]string{"zone": zone.FQDN, "name": wapiName}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var states []recordState
+	if err := c.getObjects(rt.ObjectType, recordStateFields, search, &states); err != nil {
+		return nil, fmt.Errorf("cannot get state of %s records %s in zone %s: %w", recordType, wapiName, zone, err)
+	}
+	disabled := map[string]bool{}
+	for i := range states {
+		if states[i].Disable && raw.EqualNames(states[i].Name, wapiName) {
+			disabled[states[i].Ref] = true
+		}
+	}
+	return disabled, nil
+}
+
+// softDeleteRecord disables the given record and tags it with the current time.
+func (c *dnsClient) softDeleteRecord(rt *raw.RecordType, record raw.Record) error {
+	obj := &recordDisable{
+		objectType: rt.ObjectType,
+		Disable:    true,
+		AddEa:      ibclient.EA{c.softDelete.DeletionAttribute: time.Now().UTC().Format(time.RFC3339)},
+	}
+	if _, err := c.updateObject(obj, record.GetId()); err != nil {
+		return fmt.Errorf("cannot disable %s record %s: %w", rt.Type, record.GetDNSName(), err)
+	}
+	return nil
+}
+
+// restoreRecord enables the given soft-deleted record again and removes the deletion time.
+func (c *dnsClient) restoreRecord(rt *raw.RecordType, record raw.Record) error {
+	obj := &recordDisable{
+		objectType: rt.ObjectType,
+		Disable:    false,
+		RemoveEa:   map[string]struct{}{c.softDelete.DeletionAttribute: {}},
+	}
+	if _, err := c.updateObject(obj, record.GetId()); err != nil {
+		return fmt.Errorf("cannot restore %s record %s: %w", rt.Type, record.GetDNSName(), err)
+	}
+	return nil
+}
+
+var (
+	lastPurges     = map[string]time.Time{}
+	lastPurgesLock sync.Mutex
+)
+
+// PurgeSoftDeletedRecords deletes the records in the given zone which have been soft-deleted longer than the
+// retention ago. The records of a zone are purged at most once per hour. It returns the number of deleted records.
+func (c *dnsClient) PurgeSoftDeletedRecords(ctx context.Context, zone ZoneID) (int, error) {
+	if c.softDelete == nil || !c.startPurge(zone) {
+		return 0, nil
+	}
+	purged := 0
+	for _, recordType := range softDeletableTypes {
+		deleted, err := c.getSoftDeletedRecords(zone, recordType, "")
+		if err != nil {
+			return purged, err
+		}
+		var expired []string
+		for ref, t := range deleted {
+			if time.Since(t) >= c.softDelete.Retention {
+				expired = append(expired, ref)
+			}
+		}
+		// purges count against the deletion budget
+		if err := c.deleteWithinBudget(zone, len(expired), func(i int) error {
+			if _, err := c.deleteObject(expired[i]); err != nil {
+				return fmt.Errorf("cannot purge soft-deleted record %s: %w", expired[i], err)
+			}
+			purged++
+			return nil
+		}); err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// startPurge returns true if the soft-deleted records of the given zone have not been purged within the purge
+// interval, and records the current time as time of the last purge. Purge times older than the purge interval are
+// dropped, as they do not prevent purges anymore.
+func (c *dnsClient) startPurge(zone ZoneID) bool {
+	key := c.host + "/" + zone.String()
+	lastPurgesLock.Lock()
+	defer lastPurgesLock.Unlock()
+	for k, last := range lastPurges {
+		if time.Since(last) >= purgeInterval {
+			delete(lastPurges, k)
+		}
+	}
+	if last, ok := lastPurges[key]; ok && time.Since(last) < purgeInterval {
+		return false
+	}
+	lastPurges[key] = time.Now()
+	return true
+}
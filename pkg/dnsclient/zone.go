@@ -0,0 +1,316 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+const (
+	zoneAuthRefPrefix = "zone_auth/"
+
+	zoneFormatForward = "FORWARD"
+	// primaryTypeExternal is the primary type of zones which are served by a primary outside of the grid.
+	primaryTypeExternal = "External"
+)
+
+// ZoneKind is the kind of an Infoblox zone.
+type ZoneKind string
+
+const (
+	// ZoneKindAuthoritative is an authoritative zone (zone_auth) served by the grid.
+	ZoneKindAuthoritative ZoneKind = "authoritative"
+	// ZoneKindDelegated is a delegated zone (zone_delegated) served by other name servers.
+	ZoneKindDelegated ZoneKind = "delegated"
+	// ZoneKindForward is a forward zone (zone_forward) whose queries are forwarded to other name servers.
+	ZoneKindForward ZoneKind = "forward"
+)
+
+// ZoneID identifies an Infoblox authoritative zone. It can be given as plain FQDN (example.com),
+// as view-qualified name (default/example.com), or as WAPI reference (zone_auth/ZG5z...:example.com/default).
+type ZoneID struct {
+	// View is the DNS view of the zone. It is empty if the zone was given as plain FQDN.
+	View string
+	// FQDN is the fully qualified domain name of the zone, without trailing dot.
+	FQDN string
+	// Ref is the WAPI reference of the zone. It is only known if the zone was given as reference or has been resolved.
+	Ref string
+}
+
+// ParseZoneID parses a zone given as plain FQDN, view-qualified name or WAPI reference.
+func ParseZoneID(s string) (ZoneID, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ZoneID{}, fmt.Errorf("empty zone")
+	}
+
+	if strings.HasPrefix(s, zoneAuthRefPrefix) {
+		// zone_auth/<id>:<fqdn>/<view>
+		_, name, ok := strings.Cut(s, ":")
+		if !ok {
+			return ZoneID{}, fmt.Errorf("invalid zone reference %q", s)
+		}
+		i := strings.LastIndex(name, "/")
+		if i <= 0 || i == len(name)-1 {
+			return ZoneID{}, fmt.Errorf("invalid zone reference %q", s)
+		}
+		return ZoneID{View: name[i+1:], FQDN: raw.NormalizeName(name[:i]), Ref: s}, nil
+	}
+
+	if view, fqdn, ok := strings.Cut(s, "/"); ok {
+		if view == "" || fqdn == "" {
+			return ZoneID{}, fmt.Errorf("invalid view-qualified zone %q", s)
+		}
+		return ZoneID{View: view, FQDN: raw.NormalizeName(fqdn)}, nil
+	}
+
+	return ZoneID{FQDN: raw.NormalizeName(s)}, nil
+}
+
+// String returns the view-qualified name of the zone if the view is known, the plain FQDN otherwise.
+// A zone only known by its reference is printed as reference.
+func (z ZoneID) String() string {
+	switch {
+	case z.View != "" && z.FQDN != "":
+		return z.View + "/" + z.FQDN
+	case z.FQDN != "":
+		return z.FQDN
+	default:
+		return z.Ref
+	}
+}
+
+// IsQualified returns true if both view and FQDN of the zone are known.
+func (z ZoneID) IsQualified() bool {
+	return z.View != "" && z.FQDN != ""
+}
+
+// ParseViews parses a comma-separated list of DNS views. Blank and duplicate entries are dropped.
+func ParseViews(s string) ([]string, error) {
+	var views []string
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" || containsView(views, v) {
+			continue
+		}
+		views = append(views, v)
+	}
+	if len(views) == 0 {
+		return nil, fmt.Errorf("no view found in %q", s)
+	}
+	return views, nil
+}
+
+func containsView(views []string, view string) bool {
+	for _, v := range views {
+		if v == view {
+			return true
+		}
+	}
+	return false
+}
+
+// ZoneNotFoundError is returned if a zone does not exist in the grid, or if no managed zone matches a name.
+type ZoneNotFoundError struct {
+	msg string
+}
+
+func (e *ZoneNotFoundError) Error() string {
+	return e.msg
+}
+
+// IsZoneNotFound returns true if the given error is or wraps a ZoneNotFoundError.
+func IsZoneNotFound(err error) bool {
+	var notFound *ZoneNotFoundError
+	return errors.As(err, &notFound)
+}
+
+// ResolveZone looks up the given zone in the grid and returns it with view, FQDN and reference filled in.
+// If the zone is given without view, it must be unique across all views.
+func (c *dnsClient) ResolveZone(ctx context.Context, zone ZoneID) (ZoneID, error) {
+	var zones []ibclient.ZoneAuth
+	obj := ibclient.NewZoneAuth(ibclient.ZoneAuth{})
+	if zone.Ref != "" {
+		var z ibclient.ZoneAuth
+		if err := c.client.GetObject(obj, zone.Ref, ibclient.NewQueryParams(false, nil), &z); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return ZoneID{}, fmt.Errorf("cannot get zone %s: %w", zone, err)
+			}
+		} else {
+			zones = append(zones, z)
+		}
+	} else {
+		search := map[string]string{"fqdn": zone.FQDN}
+		if zone.View != "" {
+			search["view"] = zone.View
+		}
+		if err := c.client.GetObject(obj, "", ibclient.NewQueryParams(false, search), &zones); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return ZoneID{}, fmt.Errorf("cannot get zone %s: %w", zone, err)
+			}
+		}
+	}
+
+	switch len(zones) {
+	case 0:
+		return ZoneID{}, &ZoneNotFoundError{msg: fmt.Sprintf("zone %s not found", zone)}
+	case 1:
+		return ZoneID{View: zones[0].View, FQDN: raw.NormalizeName(zones[0].Fqdn), Ref: zones[0].Ref}, nil
+	default:
+		views := make([]string, 0, len(zones))
+		for _, z := range zones {
+			views = append(views, z.View)
+		}
+		return ZoneID{}, fmt.Errorf("zone %s is ambiguous, it exists in views %s", zone, strings.Join(views, ", "))
+	}
+}
+
+// Zone contains the metadata of an Infoblox zone.
+type Zone struct {
+	ZoneID
+	// Kind is the kind of the zone. Records can only be written to authoritative zones.
+	Kind ZoneKind
+	// PrimaryType is the type of the primary server of an authoritative zone, e.g. Grid.
+	PrimaryType string
+	// Locked is true if the zone has been locked by an administrator.
+	Locked bool
+	// LockedBy is the name of the administrator who locked the zone.
+	LockedBy string
+}
+
+type wapiZone struct {
+	Ref         string `json:"_ref"`
+	Fqdn        string `json:"fqdn"`
+	View        string `json:"view"`
+	ZoneFormat  string `json:"zone_format"`
+	Disable     bool   `json:"disable"`
+	PrimaryType string `json:"primary_type,omitempty"`
+	Locked      bool   `json:"locked,omitempty"`
+	LockedBy    string `json:"locked_by,omitempty"`
+}
+
+var zoneReturnFields = map[ZoneKind][]string{
+	ZoneKindAuthoritative: {"fqdn", "view", "zone_format", "disable", "primary_type", "locked", "locked_by"},
+	ZoneKindDelegated:     {"fqdn", "view", "zone_format", "disable"},
+	ZoneKindForward:       {"fqdn", "view", "zone_format", "disable"},
+}
+
+var zoneObjectTypes = map[ZoneKind]string{
+	ZoneKindAuthoritative: "zone_auth",
+	ZoneKindDelegated:     "zone_delegated",
+	ZoneKindForward:       "zone_forward",
+}
+
+// GetManagedZones returns all enabled forward zones of the given view, or of all views if view is empty.
+// Authoritative zones are only returned if they are served by the grid. Delegated and forward zones are returned
+// as well, as they hide the records of authoritative zones for all names below them. The zones are served from
+// the process-wide zone cache, callers must not modify them.
+func (c *dnsClient) GetManagedZones(ctx context.Context, view string) ([]Zone, error) {
+	return defaultZoneCache.Get(ctx, c.zoneCacheKey(view), func(ctx context.Context) ([]Zone, error) {
+		return c.listManagedZones(ctx, view)
+	})
+}
+
+// InvalidateManagedZones drops the cached zones of the given view, so that the next call of GetManagedZones
+// lists them again. It must be called if a zone taken from the cache turned out not to exist.
+func (c *dnsClient) InvalidateManagedZones(view string) {
+	defaultZoneCache.Invalidate(c.zoneCacheKey(view))
+}
+
+// zoneCacheKey identifies the grid, the user and the view, as the visible zones depend on the permissions of the user.
+func (c *dnsClient) zoneCacheKey(view string) string {
+	return c.username + "@" + c.host + "/" + view
+}
+
+func (c *dnsClient) listManagedZones(ctx context.Context, view string) ([]Zone, error) {
+	var search map[string]string
+	if view != "" {
+		search = map[string]string{"view": view}
+	}
+
+	var zones []Zone
+	for _, kind := range []ZoneKind{ZoneKindAuthoritative, ZoneKindDelegated, ZoneKindForward} {
+		var rs []wapiZone
+		if err := c.getObjects(zoneObjectTypes[kind], zoneReturnFields[kind], search, &rs); err != nil {
+			return nil, fmt.Errorf("cannot list %s zones: %w", kind, err)
+		}
+		for _, z := range rs {
+			if z.Disable || z.ZoneFormat != zoneFormatForward {
+				continue
+			}
+			if kind == ZoneKindAuthoritative && z.PrimaryType == primaryTypeExternal {
+				continue
+			}
+			zones = append(zones, Zone{
+				ZoneID:      ZoneID{View: z.View, FQDN: raw.NormalizeName(z.Fqdn), Ref: z.Ref},
+				Kind:        kind,
+				PrimaryType: z.PrimaryType,
+				Locked:      z.Locked,
+				LockedBy:    z.LockedBy,
+			})
+		}
+	}
+	return zones, nil
+}
+
+// FindZoneForName returns the authoritative zone with the longest FQDN matching the given name. If view is not empty,
+// only zones of this view are considered. An error is returned if no zone matches, or if the name is hidden by a
+// delegated or forward zone below the matching authoritative zone.
+func FindZoneForName(zones []Zone, name, view string) (*Zone, error) {
+	name = raw.NormalizeName(name)
+
+	var best, hiding *Zone
+	for i := range zones {
+		z := &zones[i]
+		if view != "" && z.View != view {
+			continue
+		}
+		if !dnsrecord.MatchesDomain(name, z.FQDN) {
+			continue
+		}
+		if z.Kind == ZoneKindAuthoritative {
+			if best == nil || len(z.FQDN) > len(best.FQDN) {
+				best = z
+			}
+		} else if hiding == nil || len(z.FQDN) > len(hiding.FQDN) {
+			hiding = z
+		}
+	}
+
+	if best == nil {
+		return nil, &ZoneNotFoundError{msg: fmt.Sprintf("could not find DNS managed zone for name %s", name)}
+	}
+	if hiding != nil && len(hiding.FQDN) > len(best.FQDN) {
+		return nil, fmt.Errorf("name %s is not reachable in zone %s, as it is below %s zone %s", name, best.ZoneID, hiding.Kind, hiding.ZoneID)
+	}
+	return best, nil
+}
+
+// getObjects reads all WAPI objects of the given type matching the search fields into res.
+func (c *dnsClient) getObjects(objectType string, returnFields []string, search map[string]string, res interface{}) error {
+	err := c.client.GetObject(raw.NewQueryObject(objectType, returnFields), "", ibclient.NewQueryParams(false, search), res)
+	if _, ok := err.(*ibclient.NotFoundError); ok {
+		return nil
+	}
+	return err
+}
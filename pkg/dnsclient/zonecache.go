@@ -0,0 +1,186 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DefaultZoneCacheTTL is the default time the managed zones of a grid and view are cached.
+	DefaultZoneCacheTTL = 5 * time.Minute
+
+	// zoneListTimeout limits the time a refresh of the zone cache may take, as it is not cancelled with its callers.
+	zoneListTimeout = 2 * time.Minute
+)
+
+var (
+	zoneCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "infoblox_zone_cache_hits_total",
+		Help: "Number of managed zone lookups answered from the zone cache.",
+	})
+	zoneCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "infoblox_zone_cache_misses_total",
+		Help: "Number of managed zone lookups that required listing the zones of the grid.",
+	})
+
+	defaultZoneCache = NewZoneCache(DefaultZoneCacheTTL)
+)
+
+func init() {
+	metrics.Registry.MustRegister(zoneCacheHits, zoneCacheMisses)
+}
+
+// SetZoneCacheTTL sets the TTL of the process-wide zone cache used by all DNS clients.
+// A TTL of zero disables caching, concurrent listings are still de-duplicated.
+func SetZoneCacheTTL(ttl time.Duration) {
+	defaultZoneCache.SetTTL(ttl)
+}
+
+// ZoneCache caches the managed zones per key. Concurrent refreshes of the same key are de-duplicated.
+type ZoneCache struct {
+	lock    sync.Mutex
+	ttl     time.Duration
+	entries map[string]*zoneCacheEntry
+	group   singleflight.Group
+}
+
+type zoneCacheEntry struct {
+	zones []Zone
+	// expires is zero if the entry has been invalidated or has not been filled yet.
+	expires time.Time
+	// generation is increased on every invalidation, so that refreshes started before are not stored.
+	generation uint64
+}
+
+// NewZoneCache creates a new zone cache with the given TTL.
+func NewZoneCache(ttl time.Duration) *ZoneCache {
+	return &ZoneCache{
+		ttl:     ttl,
+		entries: map[string]*zoneCacheEntry{},
+	}
+}
+
+// SetTTL sets the TTL of entries stored from now on.
+func (c *ZoneCache) SetTTL(ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ttl = ttl
+}
+
+// Get returns the cached zones for the given key. If there are none or they are expired, the zones are
+// listed with the given function and stored. Callers must not modify the returned zones.
+// A refresh is shared by all concurrent callers, so the zones are listed with a context which is not cancelled with
+// the given one. A cancelled caller stops waiting for the refresh without failing the others.
+func (c *ZoneCache) Get(ctx context.Context, key string, list func(ctx context.Context) ([]Zone, error)) ([]Zone, error) {
+	if zones, ok := c.lookup(key); ok {
+		zoneCacheHits.Inc()
+		return zones, nil
+	}
+	zoneCacheMisses.Inc()
+
+	generation := c.generation(key)
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		// A refresh finished since the lookup may have stored the zones already
+		if zones, ok := c.lookup(key); ok {
+			return zones, nil
+		}
+		listCtx, cancel := context.WithTimeout(detachedContext{ctx}, zoneListTimeout)
+		defer cancel()
+		zones, err := list(listCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, generation, zones)
+		return zones, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]Zone), nil
+	}
+}
+
+// detachedContext keeps the values of its parent but is neither cancelled nor has a deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// Invalidate drops the cached zones for the given key. Refreshes already in flight are not stored.
+func (c *ZoneCache) Invalidate(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e := c.entries[key]
+	if e == nil {
+		e = &zoneCacheEntry{}
+		c.entries[key] = e
+	}
+	e.zones = nil
+	e.expires = time.Time{}
+	e.generation++
+	c.group.Forget(key)
+}
+
+func (c *ZoneCache) lookup(key string) ([]Zone, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e := c.entries[key]
+	if e == nil || e.expires.IsZero() || !time.Now().Before(e.expires) {
+		return nil, false
+	}
+	return e.zones, true
+}
+
+func (c *ZoneCache) generation(key string) uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if e := c.entries[key]; e != nil {
+		return e.generation
+	}
+	return 0
+}
+
+func (c *ZoneCache) store(key string, generation uint64, zones []Zone) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.ttl <= 0 {
+		return
+	}
+	e := c.entries[key]
+	if e == nil {
+		e = &zoneCacheEntry{}
+		c.entries[key] = e
+	}
+	if e.generation != generation {
+		return
+	}
+	e.zones = zones
+	e.expires = time.Now().Add(c.ttl)
+}
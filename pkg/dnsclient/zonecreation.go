@@ -0,0 +1,172 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// ZoneOwner is the value of the owner extensible attribute of zones created by this extension.
+const ZoneOwner = "gardener-extension-provider-dns-infoblox"
+
+// ZoneCreateOptions are the settings of zones created by the extension.
+type ZoneCreateOptions struct {
+	// GridPrimaries are the names of the grid members serving the zone as primaries.
+	GridPrimaries []string
+	// GridSecondaries are the names of the grid members serving the zone as secondaries.
+	GridSecondaries []string
+	// NSGroup is the name server group of the zone. It takes precedence over the grid members.
+	NSGroup string
+	// OwnerAttribute is the extensible attribute tagging the zone as owned by the extension.
+	OwnerAttribute string
+}
+
+type memberServer struct {
+	Name string `json:"name"`
+}
+
+// newZoneAuth is the body of a zone_auth created by the extension.
+type newZoneAuth struct {
+	Fqdn            string         `json:"fqdn"`
+	View            string         `json:"view,omitempty"`
+	GridPrimary     []memberServer `json:"grid_primary,omitempty"`
+	GridSecondaries []memberServer `json:"grid_secondaries,omitempty"`
+	NsGroup         string         `json:"ns_group,omitempty"`
+	Ea              ibclient.EA    `json:"extattrs,omitempty"`
+}
+
+func (z *newZoneAuth) ObjectType() string          { return "zone_auth" }
+func (z *newZoneAuth) ReturnFields() []string      { return nil }
+func (z *newZoneAuth) EaSearch() ibclient.EASearch { return nil }
+
+var _ ibclient.IBObject = (*newZoneAuth)(nil)
+
+// ZoneToCreate returns the zone to create for the given name in the given view if it is below one of the given
+// parent domains. The zone is the domain one label below the longest matching parent domain, or the parent
+// domain itself if the name equals it. Parent domains can be qualified with a view.
+func ZoneToCreate(name, view string, parents []string) (ZoneID, bool) {
+	name = raw.NormalizeName(name)
+
+	var parent string
+	for _, p := range parents {
+		id, err := ParseZoneID(p)
+		if err != nil || id.Ref != "" {
+			continue
+		}
+		if id.View != "" && id.View != view {
+			continue
+		}
+		if dnsrecord.MatchesDomain(name, id.FQDN) && len(id.FQDN) > len(parent) {
+			parent = id.FQDN
+		}
+	}
+	if parent == "" {
+		return ZoneID{}, false
+	}
+	if name == parent {
+		return ZoneID{View: view, FQDN: parent}, true
+	}
+
+	labels := strings.Split(strings.TrimSuffix(name, "."+parent), ".")
+	label := labels[len(labels)-1]
+	if label == "*" {
+		return ZoneID{}, false
+	}
+	return ZoneID{View: view, FQDN: label + "." + parent}, true
+}
+
+// CreateZone creates the given authoritative zone, served by the configured grid members or name server group,
+// and tags it as owned by the extension.
+func (c *dnsClient) CreateZone(ctx context.Context, zone ZoneID, opts ZoneCreateOptions) (ZoneID, error) {
+	if opts.NSGroup == "" && len(opts.GridPrimaries) == 0 {
+		return ZoneID{}, fmt.Errorf("cannot create zone %s: neither name server group nor grid primaries configured", zone)
+	}
+
+	obj := &newZoneAuth{
+		Fqdn:    zone.FQDN,
+		View:    zone.View,
+		NsGroup: opts.NSGroup,
+	}
+	if opts.NSGroup == "" {
+		for _, name := range opts.GridPrimaries {
+			obj.GridPrimary = append(obj.GridPrimary, memberServer{Name: name})
+		}
+		for _, name := range opts.GridSecondaries {
+			obj.GridSecondaries = append(obj.GridSecondaries, memberServer{Name: name})
+		}
+	}
+	if opts.OwnerAttribute != "" {
+		obj.Ea = ibclient.EA{opts.OwnerAttribute: ZoneOwner}
+	}
+
+	// zones are created immediately, even if changes of records are scheduled
+	ref, err := c.applied(checkQueued(c.client.CreateObject(obj)))
+	if err != nil {
+		return ZoneID{}, fmt.Errorf("cannot create zone %s: %w", zone, err)
+	}
+	c.InvalidateManagedZones(zone.View)
+	c.InvalidateManagedZones("")
+	return ZoneID{View: zone.View, FQDN: zone.FQDN, Ref: ref}, nil
+}
+
+type zoneRecord struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// DeleteZoneIfEmpty deletes the given zone if it is tagged as owned by the extension with the given attribute
+// and contains no records apart from its SOA and apex NS records. It returns true if the zone has been deleted.
+func (c *dnsClient) DeleteZoneIfEmpty(ctx context.Context, zone ZoneID, ownerAttribute string) (bool, error) {
+	search := map[string]string{"fqdn": zone.FQDN}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var zones []ibclient.ZoneAuth
+	if err := c.getObjects("zone_auth", []string{"fqdn", "view", "extattrs"}, search, &zones); err != nil {
+		return false, fmt.Errorf("cannot get zone %s: %w", zone, err)
+	}
+	if len(zones) != 1 || zones[0].Ea[ownerAttribute] != ZoneOwner {
+		return false, nil
+	}
+
+	search = map[string]string{"zone": zone.FQDN}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	var records []zoneRecord
+	if err := c.getObjects("allrecords", []string{"name", "type"}, search, &records); err != nil {
+		return false, fmt.Errorf("cannot list records of zone %s: %w", zone, err)
+	}
+	for _, r := range records {
+		if r.Type == "record:soa" || (r.Type == "record:ns" && r.Name == "") {
+			continue
+		}
+		return false, nil
+	}
+
+	if _, err := c.applied(checkQueued(c.client.DeleteObject(zones[0].Ref))); err != nil {
+		return false, fmt.Errorf("cannot delete zone %s: %w", zone, err)
+	}
+	c.InvalidateManagedZones(zone.View)
+	c.InvalidateManagedZones("")
+	return true, nil
+}
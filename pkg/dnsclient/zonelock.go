@@ -0,0 +1,100 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+// ZoneLockedError is returned if a zone cannot be written because it is locked by an admin or disabled.
+type ZoneLockedError struct {
+	// Zone is the locked or disabled zone.
+	Zone ZoneID
+	// LockedBy is the admin holding the lock. It is empty if the zone is only disabled.
+	LockedBy string
+	// Disabled is true if the zone is disabled.
+	Disabled bool
+}
+
+func (e *ZoneLockedError) Error() string {
+	switch {
+	case e.LockedBy != "":
+		return fmt.Sprintf("zone %s is locked by %s", e.Zone, e.LockedBy)
+	case e.Disabled:
+		return fmt.Sprintf("zone %s is disabled", e.Zone)
+	default:
+		return fmt.Sprintf("zone %s is locked", e.Zone)
+	}
+}
+
+// IsZoneLocked returns true if the given error is or wraps a ZoneLockedError.
+func IsZoneLocked(err error) bool {
+	var locked *ZoneLockedError
+	return errors.As(err, &locked)
+}
+
+type zoneLockState struct {
+	Ref      string `json:"_ref"`
+	Fqdn     string `json:"fqdn"`
+	View     string `json:"view"`
+	Locked   bool   `json:"locked"`
+	LockedBy string `json:"locked_by"`
+	Disable  bool   `json:"disable"`
+}
+
+var zoneLockStateFields = []string{"fqdn", "view", "locked", "locked_by", "disable"}
+
+// CheckZoneWritable returns a ZoneLockedError if the given zone is locked or disabled, so that it is detected
+// before records are mutated rather than by failing writes. A ZoneNotFoundError is returned if the zone does not
+// exist anymore, and the cached managed zones are dropped, so that the zone is looked up again.
+func (c *dnsClient) CheckZoneWritable(ctx context.Context, zone ZoneID) error {
+	var states []zoneLockState
+	if zone.Ref != "" {
+		var state zoneLockState
+		if err := c.client.GetObject(raw.NewQueryObject("zone_auth", zoneLockStateFields), zone.Ref, ibclient.NewQueryParams(false, nil), &state); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return fmt.Errorf("cannot get zone %s: %w", zone, err)
+			}
+		} else {
+			states = append(states, state)
+		}
+	} else {
+		search := map[string]string{"fqdn": zone.FQDN}
+		if zone.View != "" {
+			search["view"] = zone.View
+		}
+		if err := c.getObjects("zone_auth", zoneLockStateFields, search, &states); err != nil {
+			return fmt.Errorf("cannot get zone %s: %w", zone, err)
+		}
+	}
+
+	if len(states) == 0 {
+		c.InvalidateManagedZones(zone.View)
+		c.InvalidateManagedZones("")
+		return &ZoneNotFoundError{msg: fmt.Sprintf("zone %s not found", zone)}
+	}
+	for _, s := range states {
+		if s.Locked || s.Disable {
+			return &ZoneLockedError{Zone: zone, LockedBy: s.LockedBy, Disabled: s.Disable}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+type zoneSerialState struct {
+	Ref    string `json:"_ref"`
+	Fqdn   string `json:"fqdn"`
+	View   string `json:"view"`
+	Serial uint32 `json:"soa_serial_number"`
+	// PrimaryType is the type of the primary server of the zone, e.g. Grid or External.
+	PrimaryType string `json:"primary_type"`
+}
+
+var zoneSerialStateFields = []string{"fqdn", "view", "soa_serial_number", "primary_type"}
+
+// zoneSerialPrimaryTypeExternal is the primary type of zones whose primary server is outside of the grid.
+const zoneSerialPrimaryTypeExternal = "External"
+
+// nonAuthoritativeZoneTypes are the object types of zones the grid does not hold an SOA record for.
+var nonAuthoritativeZoneTypes = []string{"zone_delegated", "zone_forward"}
+
+// NoZoneSerialError is returned if the grid does not maintain the serial of a zone, e.g. for delegated and forward
+// zones, or for zones with an external primary server.
+type NoZoneSerialError struct {
+	msg string
+}
+
+func (e *NoZoneSerialError) Error() string {
+	return e.msg
+}
+
+// IsNoZoneSerial returns true if the given error is or wraps a NoZoneSerialError.
+func IsNoZoneSerial(err error) bool {
+	var noSerial *NoZoneSerialError
+	return errors.As(err, &noSerial)
+}
+
+// GetZoneSerial returns the serial of the SOA record of the given zone. The serial is increased by the grid with
+// every change of the zone, so that it is a cheap indicator for changes of its records. A NoZoneSerialError is
+// returned for zones whose serial is not maintained by the grid, so that their records are always verified.
+func (c *dnsClient) GetZoneSerial(ctx context.Context, zone ZoneID) (uint32, error) {
+	var states []zoneSerialState
+	if zone.Ref != "" && !strings.HasPrefix(zone.Ref, "zone_auth/") {
+		return 0, &NoZoneSerialError{msg: fmt.Sprintf("zone %s is not authoritative", zone)}
+	}
+	if zone.Ref != "" {
+		var state zoneSerialState
+		if err := c.client.GetObject(raw.NewQueryObject("zone_auth", zoneSerialStateFields), zone.Ref, ibclient.NewQueryParams(false, nil), &state); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); !ok {
+				return 0, fmt.Errorf("cannot get zone %s: %w", zone, err)
+			}
+		} else {
+			states = append(states, state)
+		}
+	} else {
+		search := map[string]string{"fqdn": zone.FQDN}
+		if zone.View != "" {
+			search["view"] = zone.View
+		}
+		if err := c.getObjects("zone_auth", zoneSerialStateFields, search, &states); err != nil {
+			return 0, fmt.Errorf("cannot get zone %s: %w", zone, err)
+		}
+	}
+
+	switch len(states) {
+	case 0:
+		return 0, c.getNonAuthoritativeZone(zone)
+	case 1:
+		if states[0].PrimaryType == zoneSerialPrimaryTypeExternal {
+			return 0, &NoZoneSerialError{msg: fmt.Sprintf("zone %s has an external primary server", zone)}
+		}
+		return states[0].Serial, nil
+	default:
+		return 0, fmt.Errorf("zone %s is ambiguous, it exists in %d views", zone, len(states))
+	}
+}
+
+// getNonAuthoritativeZone returns a NoZoneSerialError if the given zone exists as delegated or forward zone, and a
+// ZoneNotFoundError otherwise.
+func (c *dnsClient) getNonAuthoritativeZone(zone ZoneID) error {
+	search := map[string]string{"fqdn": zone.FQDN}
+	if zone.View != "" {
+		search["view"] = zone.View
+	}
+	for _, objectType := range nonAuthoritativeZoneTypes {
+		var states []zoneSerialState
+		if err := c.getObjects(objectType, []string{"fqdn", "view"}, search, &states); err != nil {
+			return fmt.Errorf("cannot get zone %s: %w", zone, err)
+		}
+		if len(states) > 0 {
+			return &NoZoneSerialError{msg: fmt.Sprintf("zone %s is not authoritative", zone)}
+		}
+	}
+	return &ZoneNotFoundError{msg: fmt.Sprintf("zone %s not found", zone)}
+}
+
+// ZoneSerials tracks the SOA serials of zones at the last verification of their records, so that the records of
+// a zone are only verified again once its serial has changed.
+type ZoneSerials struct {
+	maxAge time.Duration
+
+	lock     sync.Mutex
+	verified map[string]verifiedSerial
+}
+
+type verifiedSerial struct {
+	serial uint32
+	at     time.Time
+}
+
+// NewZoneSerials creates a tracker of zone serials. Zones are verified again after maxAge even if their serial has
+// not changed, unless maxAge is zero.
+func NewZoneSerials(maxAge time.Duration) *ZoneSerials {
+	return &ZoneSerials{maxAge: maxAge, verified: map[string]verifiedSerial{}}
+}
+
+// Changed returns true if the records of the zone with the given key need to be verified, i.e. if the zone has not
+// been verified yet, its serial differs from the one at the last verification, or the verification is too old.
+func (s *ZoneSerials) Changed(key string, serial uint32, now time.Time) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	v, ok := s.verified[key]
+	return !ok || v.serial != serial || (s.maxAge > 0 && now.Sub(v.at) >= s.maxAge)
+}
+
+// Verified records that the records of the zone with the given key have been verified at the given serial.
+func (s *ZoneSerials) Verified(key string, serial uint32, now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.verified[key] = verifiedSerial{serial: serial, at: now}
+}
+
+// Forget removes the zones for which keep returns false, e.g. zones without DNSRecords.
+func (s *ZoneSerials) Forget(keep func(key string) bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key := range s.verified {
+		if !keep(key) {
+			delete(s.verified, key)
+		}
+	}
+}
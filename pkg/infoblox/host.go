@@ -0,0 +1,103 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// RecordHost is an Infoblox host record (record:host). Unlike the other record types, it holds all IPv4 and
+// IPv6 addresses of a name, and ties them to the IPAM networks of its network view.
+type RecordHost struct {
+	Ref             string        `json:"_ref,omitempty"`
+	Name            string        `json:"name,omitempty"`
+	View            string        `json:"view,omitempty"`
+	Zone            string        `json:"zone,omitempty"`
+	NetworkView     string        `json:"network_view,omitempty"`
+	ConfigureForDNS bool          `json:"configure_for_dns"`
+	Ipv4Addrs       []HostAddress `json:"ipv4addrs"`
+	Ipv6Addrs       []HostAddress `json:"ipv6addrs"`
+	UseTtl          bool          `json:"use_ttl"`
+	Ttl             uint32        `json:"ttl,omitempty"`
+}
+
+// HostAddress is an IPv4 or IPv6 address of a host record.
+type HostAddress struct {
+	Ref              string `json:"_ref,omitempty"`
+	Ipv4Addr         string `json:"ipv4addr,omitempty"`
+	Ipv6Addr         string `json:"ipv6addr,omitempty"`
+	ConfigureForDHCP bool   `json:"configure_for_dhcp"`
+	Mac              string `json:"mac,omitempty"`
+	Duid             string `json:"duid,omitempty"`
+}
+
+// GetValue returns the IPv4 or IPv6 address.
+func (a HostAddress) GetValue() string {
+	if a.Ipv4Addr != "" {
+		return a.Ipv4Addr
+	}
+	return a.Ipv6Addr
+}
+
+func (r *RecordHost) ObjectType() string { return "record:host" }
+func (r *RecordHost) ReturnFields() []string {
+	return []string{"name", "view", "zone", "network_view", "configure_for_dns", "ipv4addrs", "ipv6addrs", "ttl", "use_ttl"}
+}
+func (r *RecordHost) EaSearch() ibclient.EASearch { return nil }
+
+// Addresses returns the addresses of the given record type, which must be A or AAAA.
+func (r *RecordHost) Addresses(recordType string) []HostAddress {
+	if recordType == Type_AAAA {
+		return r.Ipv6Addrs
+	}
+	return r.Ipv4Addrs
+}
+
+// SetAddresses sets the addresses of the given record type, which must be A or AAAA.
+func (r *RecordHost) SetAddresses(recordType string, addrs []HostAddress) {
+	if recordType == Type_AAAA {
+		r.Ipv6Addrs = addrs
+	} else {
+		r.Ipv4Addrs = addrs
+	}
+}
+
+func (r *RecordHost) GetTTL() int    { return int(r.Ttl) }
+func (r *RecordHost) SetTTL(ttl int) { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
+
+// PrepareUpdate returns a copy of the host record containing only fields which can be updated.
+// The references of the addresses are dropped, the address lists replace the existing ones. Empty address
+// lists are sent as such, as omitted ones would keep the existing addresses.
+func (r *RecordHost) PrepareUpdate() *RecordHost {
+	n := &RecordHost{
+		Name:            r.Name,
+		ConfigureForDNS: r.ConfigureForDNS,
+		Ipv4Addrs:       []HostAddress{},
+		Ipv6Addrs:       []HostAddress{},
+		UseTtl:          r.UseTtl,
+		Ttl:             r.Ttl,
+	}
+	for _, a := range r.Ipv4Addrs {
+		a.Ref = ""
+		n.Ipv4Addrs = append(n.Ipv4Addrs, a)
+	}
+	for _, a := range r.Ipv6Addrs {
+		a.Ref = ""
+		n.Ipv6Addrs = append(n.Ipv6Addrs, a)
+	}
+	return n
+}
+
+var _ ibclient.IBObject = (*RecordHost)(nil)
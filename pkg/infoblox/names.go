@@ -0,0 +1,117 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	wildcardLabel        = "*"
+	escapedWildcardLabel = "\\052"
+)
+
+// idnaProfile converts internationalized names to punycode. Underscores are allowed in addition to
+// the host name rules, as they are used for labels like _acme-challenge.
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.StrictDomainName(false),
+	idna.VerifyDNSLength(true),
+	idna.BidiRule(),
+)
+
+// ToASCIIName validates a DNS name and converts internationalized labels to punycode. The trailing dot is removed.
+// A leading wildcard label, either as '*' or escaped as '\052', is kept as '*'.
+func ToASCIIName(name string) (string, error) {
+	n := strings.TrimSuffix(name, ".")
+	wildcard := false
+	if n == wildcardLabel || n == escapedWildcardLabel {
+		return "", fmt.Errorf("invalid DNS name %q: wildcard label without domain", name)
+	}
+	for _, prefix := range []string{wildcardLabel + ".", escapedWildcardLabel + "."} {
+		if strings.HasPrefix(n, prefix) {
+			wildcard = true
+			n = n[len(prefix):]
+			break
+		}
+	}
+
+	ascii, err := idnaProfile.ToASCII(n)
+	if err != nil {
+		return "", fmt.Errorf("invalid DNS name %q: %w", name, err)
+	}
+	ascii = strings.ToLower(ascii)
+	for _, label := range strings.Split(ascii, ".") {
+		if err := validateLabel(label); err != nil {
+			return "", fmt.Errorf("invalid DNS name %q: %w", name, err)
+		}
+	}
+
+	if wildcard {
+		ascii = wildcardLabel + "." + ascii
+	}
+	return ascii, nil
+}
+
+// ToWAPIName validates a DNS name and converts it into the form expected by WAPI: internationalized labels
+// are converted to punycode, the trailing dot is removed, and a leading wildcard label is escaped as '\052'.
+func ToWAPIName(name string) (string, error) {
+	ascii, err := ToASCIIName(name)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(ascii, wildcardLabel+".") {
+		ascii = escapedWildcardLabel + ascii[len(wildcardLabel):]
+	}
+	return ascii, nil
+}
+
+// ToUnicodeName converts a DNS name read from WAPI into its Unicode form with an unescaped wildcard label.
+// Names which cannot be converted are returned normalized but otherwise unchanged.
+func ToUnicodeName(name string) string {
+	n := NormalizeHostname(name)
+	wildcard := strings.HasPrefix(n, wildcardLabel+".")
+	if wildcard {
+		n = n[len(wildcardLabel)+1:]
+	}
+	if u, err := idnaProfile.ToUnicode(n); err == nil {
+		n = u
+	}
+	if wildcard {
+		n = wildcardLabel + "." + n
+	}
+	return n
+}
+
+func validateLabel(label string) error {
+	if label == "" {
+		return fmt.Errorf("empty label")
+	}
+	if len(label) > 63 {
+		return fmt.Errorf("label %q exceeds 63 characters", label)
+	}
+	for _, c := range label {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return fmt.Errorf("label %q contains invalid character %q", label, c)
+		}
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return fmt.Errorf("label %q must not start or end with a hyphen", label)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	"net"
+	"strings"
+)
+
+// NormalizeName returns the canonical form of a DNS name used for comparisons. Names are compared
+// case-insensitively, without trailing dot, in punycode, and with an escaped wildcard label converted back to '*'.
+func NormalizeName(name string) string {
+	n := NormalizeHostname(name)
+	if ascii, err := ToASCIIName(n); err == nil {
+		return ascii
+	}
+	return strings.ToLower(n)
+}
+
+// EqualNames reports whether two DNS names are equivalent.
+func EqualNames(a, b string) bool {
+	return NormalizeName(a) == NormalizeName(b)
+}
+
+// NormalizeIP returns the canonical form of an IP address, i.e. dotted decimal for IPv4
+// and the compressed lower case form for IPv6. Invalid addresses are returned unchanged.
+func NormalizeIP(value string) string {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return value
+	}
+	return ip.String()
+}
+
+// NormalizeValue returns the canonical form of a value of the given record type used for comparisons.
+// Values of unknown record types are returned unchanged.
+func NormalizeValue(recordType, value string) string {
+	rt, err := LookupRecordType(recordType)
+	if err != nil {
+		return value
+	}
+	return rt.Normalize(value)
+}
+
+// EqualValues reports whether two values of the given record type are equivalent.
+func EqualValues(recordType, a, b string) bool {
+	return NormalizeValue(recordType, a) == NormalizeValue(recordType, b)
+}
@@ -26,8 +26,13 @@ const (
 	Type_CNAME = "CNAME"
 	Type_AAAA  = "AAAA"
 	Type_TXT   = "TXT"
+	Type_NS    = "NS"
+	Type_ALIAS = "ALIAS"
 )
 
+// AliasTargetTypes are the record types an Infoblox alias record can resolve to.
+var AliasTargetTypes = []string{"A", "AAAA", "MX", "NAPTR", "PTR", "SPF", "SRV", "TXT"}
+
 type Base_Record interface {
 	GetId() string
 	GetType() string
@@ -42,6 +47,8 @@ type Base_Record interface {
 type Record interface {
 	Base_Record
 	PrepareUpdate() Base_Record
+	// GetEA returns the extensible attributes of the record.
+	GetEA() ibclient.EA
 }
 
 type RecordA ibclient.RecordA
@@ -54,6 +61,7 @@ func (r *RecordA) GetValue() string         { return r.Ipv4Addr }
 func (r *RecordA) GetTTL() int              { return int(r.Ttl) }
 func (r *RecordA) SetTTL(ttl int)           { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
 func (r *RecordA) Copy() Base_Record        { n := *r; return &n }
+func (r *RecordA) GetEA() ibclient.EA       { return r.Ea }
 func (r *RecordA) PrepareUpdate() Base_Record {
 	n := *r
 	n.Zone = ""
@@ -72,6 +80,7 @@ func (r *RecordAAAA) GetValue() string         { return r.Ipv6Addr }
 func (r *RecordAAAA) GetTTL() int              { return int(r.Ttl) }
 func (r *RecordAAAA) SetTTL(ttl int)           { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
 func (r *RecordAAAA) Copy() Base_Record        { n := *r; return &n }
+func (r *RecordAAAA) GetEA() ibclient.EA       { return r.Ea }
 func (r *RecordAAAA) PrepareUpdate() Base_Record {
 	n := *r
 	n.Zone = ""
@@ -90,6 +99,7 @@ func (r *RecordCNAME) GetValue() string           { return r.Canonical }
 func (r *RecordCNAME) GetTTL() int                { return int(r.Ttl) }
 func (r *RecordCNAME) SetTTL(ttl int)             { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
 func (r *RecordCNAME) Copy() Base_Record          { n := *r; return &n }
+func (r *RecordCNAME) GetEA() ibclient.EA         { return r.Ea }
 func (r *RecordCNAME) PrepareUpdate() Base_Record { n := *r; n.Zone = ""; n.View = ""; return &n }
 
 type RecordTXT ibclient.RecordTXT
@@ -98,18 +108,79 @@ func (r *RecordTXT) GetType() string            { return Type_TXT }
 func (r *RecordTXT) GetId() string              { return r.Ref }
 func (r *RecordTXT) GetDNSName() string         { return r.Name }
 func (r *RecordTXT) GetSetIdentifier() string   { return "" }
-func (r *RecordTXT) GetValue() string           { return EnsureQuotedText(r.Text) }
+func (r *RecordTXT) GetValue() string           { return SplitTXT(r.Text) }
 func (r *RecordTXT) GetTTL() int                { return int(r.Ttl) }
 func (r *RecordTXT) SetTTL(ttl int)             { r.Ttl = uint(ttl); r.UseTtl = ttl != 0 }
 func (r *RecordTXT) Copy() Base_Record          { n := *r; return &n }
+func (r *RecordTXT) GetEA() ibclient.EA         { return r.Ea }
 func (r *RecordTXT) PrepareUpdate() Base_Record { n := *r; n.Zone = ""; n.View = ""; return &n }
 
+// RecordNS is a name server record used to delegate a sub domain. The TTL is inherited
+// from the zone, as WAPI does not support a TTL for record:ns objects.
+type RecordNS ibclient.RecordNS
+
+func (r *RecordNS) GetType() string            { return Type_NS }
+func (r *RecordNS) GetId() string              { return r.Ref }
+func (r *RecordNS) GetDNSName() string         { return r.Name }
+func (r *RecordNS) GetSetIdentifier() string   { return "" }
+func (r *RecordNS) GetValue() string           { return r.Nameserver }
+func (r *RecordNS) GetTTL() int                { return 0 }
+func (r *RecordNS) SetTTL(ttl int)             {}
+func (r *RecordNS) Copy() Base_Record          { n := *r; return &n }
+func (r *RecordNS) GetEA() ibclient.EA         { return nil }
+func (r *RecordNS) PrepareUpdate() Base_Record { n := *r; n.Zone = ""; n.View = ""; return &n }
+
+// RecordAlias is an Infoblox alias record (record:alias). It lets a name which cannot hold a CNAME,
+// e.g. a zone apex, follow the records of the given target type of another name.
+type RecordAlias struct {
+	Ref        string      `json:"_ref,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	TargetName string      `json:"target_name,omitempty"`
+	TargetType string      `json:"target_type,omitempty"`
+	View       string      `json:"view,omitempty"`
+	Zone       string      `json:"zone,omitempty"`
+	UseTtl     bool        `json:"use_ttl"`
+	Ttl        uint32      `json:"ttl"`
+	Ea         ibclient.EA `json:"extattrs,omitempty"`
+}
+
+// NewRecordAlias creates a new alias record for the given name following targetName.
+func NewRecordAlias(view, name, targetName, targetType string, ttl int64) *RecordAlias {
+	r := &RecordAlias{
+		Name:       name,
+		TargetName: targetName,
+		TargetType: targetType,
+		View:       view,
+	}
+	r.SetTTL(int(ttl))
+	return r
+}
+
+func (r *RecordAlias) ObjectType() string { return "record:alias" }
+func (r *RecordAlias) ReturnFields() []string {
+	return []string{"name", "target_name", "target_type", "view", "zone", "ttl", "use_ttl", "extattrs"}
+}
+func (r *RecordAlias) EaSearch() ibclient.EASearch { return nil }
+
+func (r *RecordAlias) GetType() string            { return Type_ALIAS }
+func (r *RecordAlias) GetId() string              { return r.Ref }
+func (r *RecordAlias) GetDNSName() string         { return r.Name }
+func (r *RecordAlias) GetSetIdentifier() string   { return "" }
+func (r *RecordAlias) GetValue() string           { return r.TargetName }
+func (r *RecordAlias) GetTTL() int                { return int(r.Ttl) }
+func (r *RecordAlias) SetTTL(ttl int)             { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
+func (r *RecordAlias) Copy() Base_Record          { n := *r; return &n }
+func (r *RecordAlias) GetEA() ibclient.EA         { return r.Ea }
+func (r *RecordAlias) PrepareUpdate() Base_Record { n := *r; n.Zone = ""; n.View = ""; return &n }
+
+var _ ibclient.IBObject = (*RecordAlias)(nil)
+
 var _ Base_Record = (*RecordA)(nil)
 var _ Base_Record = (*RecordAAAA)(nil)
 var _ Base_Record = (*RecordCNAME)(nil)
 var _ Base_Record = (*RecordTXT)(nil)
-
-type RecordNS ibclient.RecordNS
+var _ Base_Record = (*RecordNS)(nil)
+var _ Base_Record = (*RecordAlias)(nil)
 
 func EnsureQuotedText(v string) string {
 	if _, err := strconv.Unquote(v); err != nil {
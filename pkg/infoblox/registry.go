@@ -0,0 +1,317 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// RecordSpec describes a single record to be written to Infoblox.
+type RecordSpec struct {
+	View  string
+	Name  string
+	Value string
+	TTL   int64
+	// TargetType is the record type alias records resolve to.
+	TargetType string
+	// Glue are the addresses of name servers by name, which NS records need for name servers within the delegated domain.
+	Glue map[string][]string
+	// Ea are the extensible attributes of the record. They are ignored for record types without extensible attributes.
+	Ea ibclient.EA
+}
+
+// RecordType describes how a DNS record type is mapped to Infoblox WAPI objects.
+type RecordType struct {
+	// Type is the DNS record type, e.g. A or TXT.
+	Type string
+	// ObjectType is the WAPI object type, e.g. record:a.
+	ObjectType string
+	// ReturnFields are the fields requested when reading objects of this type.
+	ReturnFields []string
+	// ParseValue validates a DNSRecord value and converts it into the value stored in the WAPI object.
+	ParseValue func(value string) (string, error)
+	// FormatValue converts a value stored in a WAPI object into its DNSRecord representation.
+	FormatValue func(value string) string
+	// Normalize returns the canonical form of a value used for comparisons. It must accept both DNSRecord
+	// values and values formatted by FormatValue.
+	Normalize func(value string) string
+	// Matches reports whether the attributes of an existing record other than its name and value,
+	// e.g. the TTL, match the given spec. Defaults to comparing the TTL.
+	Matches func(record Record, spec RecordSpec) bool
+	// Validate checks a record spec with a parsed value, e.g. the target type of alias records. It is called before
+	// existing records are changed, so that an invalid spec does not leave a partial record set.
+	Validate func(spec RecordSpec) error
+	// New creates the WAPI object for the given record spec. The spec value has already been parsed.
+	New func(ctx context.Context, spec RecordSpec) (ibclient.IBObject, error)
+	// Decode unmarshals the response of a WAPI read request into records.
+	Decode func(data []byte) ([]Record, error)
+}
+
+// QueryObject returns an empty WAPI object of this type to be used for read requests.
+func (t *RecordType) QueryObject() ibclient.IBObject {
+	return NewQueryObject(t.ObjectType, t.ReturnFields)
+}
+
+// NewQueryObject returns an empty WAPI object of the given type to be used for read requests.
+func NewQueryObject(objectType string, returnFields []string) ibclient.IBObject {
+	return &queryObject{objectType: objectType, returnFields: returnFields}
+}
+
+type queryObject struct {
+	objectType   string
+	returnFields []string
+}
+
+func (o *queryObject) ObjectType() string          { return o.objectType }
+func (o *queryObject) ReturnFields() []string      { return o.returnFields }
+func (o *queryObject) EaSearch() ibclient.EASearch { return nil }
+
+var recordTypes = map[string]*RecordType{}
+
+// RegisterRecordType adds a record type to the registry. It panics if the type is incomplete or already registered.
+func RegisterRecordType(t *RecordType) {
+	if t.Type == "" || t.ObjectType == "" || t.New == nil || t.Decode == nil {
+		panic(fmt.Sprintf("incomplete registration of record type %q", t.Type))
+	}
+	if _, ok := recordTypes[t.Type]; ok {
+		panic(fmt.Sprintf("record type %s registered twice", t.Type))
+	}
+	if t.ParseValue == nil {
+		t.ParseValue = func(value string) (string, error) { return value, nil }
+	}
+	if t.FormatValue == nil {
+		t.FormatValue = func(value string) string { return value }
+	}
+	if t.Normalize == nil {
+		t.Normalize = func(value string) string { return value }
+	}
+	if t.Matches == nil {
+		t.Matches = matchesTTL
+	}
+	if t.Validate == nil {
+		t.Validate = func(RecordSpec) error { return nil }
+	}
+	recordTypes[t.Type] = t
+}
+
+// LookupRecordType returns the registered record type or an error if the type is not supported.
+func LookupRecordType(recordType string) (*RecordType, error) {
+	t, ok := recordTypes[recordType]
+	if !ok {
+		return nil, fmt.Errorf("record type %s not supported, must be one of %v", recordType, SupportedRecordTypes())
+	}
+	return t, nil
+}
+
+// SupportedRecordTypes returns the sorted list of registered record types.
+func SupportedRecordTypes() []string {
+	types := make([]string, 0, len(recordTypes))
+	for t := range recordTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func decodeRecords[T any, PT interface {
+	*T
+	Record
+}](data []byte) ([]Record, error) {
+	rs := []T{}
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return nil, err
+	}
+	records := make([]Record, 0, len(rs))
+	for i := range rs {
+		records = append(records, PT(&rs[i]))
+	}
+	return records, nil
+}
+
+func parseIPv4(value string) (string, error) {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		return "", fmt.Errorf("invalid IPv4 address %q", value)
+	}
+	return ip.String(), nil
+}
+
+func parseIPv6(value string) (string, error) {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() != nil {
+		return "", fmt.Errorf("invalid IPv6 address %q", value)
+	}
+	return ip.String(), nil
+}
+
+func parseHostname(value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("empty host name")
+	}
+	return ToASCIIName(value)
+}
+
+func matchesTTL(record Record, spec RecordSpec) bool {
+	return int64(record.GetTTL()) == spec.TTL
+}
+
+// glueAddresses returns the normalized and sorted glue addresses of the given name server.
+func glueAddresses(glue map[string][]string, nameserver string) []string {
+	var addresses []string
+	for name, ips := range glue {
+		if NormalizeName(name) != NormalizeName(nameserver) {
+			continue
+		}
+		for _, ip := range ips {
+			addresses = append(addresses, NormalizeIP(ip))
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// needsGlue returns true if the given name server is within the domain delegated by the NS records with the given name.
+func needsGlue(name, nameserver string) bool {
+	name, nameserver = NormalizeName(name), NormalizeName(nameserver)
+	return nameserver == name || strings.HasSuffix(nameserver, "."+name)
+}
+
+func init() {
+	RegisterRecordType(&RecordType{
+		Type:         Type_A,
+		ObjectType:   "record:a",
+		ReturnFields: ibclient.NewEmptyRecordA().ReturnFields(),
+		ParseValue:   parseIPv4,
+		Normalize:    NormalizeIP,
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			return ibclient.NewRecordA(spec.View, "", spec.Name, spec.Value, uint32(spec.TTL), spec.TTL != 0, "", spec.Ea, ""), nil
+		},
+		Decode: decodeRecords[RecordA],
+	})
+	RegisterRecordType(&RecordType{
+		Type:         Type_AAAA,
+		ObjectType:   "record:aaaa",
+		ReturnFields: ibclient.NewEmptyRecordAAAA().ReturnFields(),
+		ParseValue:   parseIPv6,
+		Normalize:    NormalizeIP,
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			return ibclient.NewRecordAAAA(spec.View, spec.Name, spec.Value, spec.TTL != 0, uint32(spec.TTL), "", spec.Ea, ""), nil
+		},
+		Decode: decodeRecords[RecordAAAA],
+	})
+	RegisterRecordType(&RecordType{
+		Type:         Type_CNAME,
+		ObjectType:   "record:cname",
+		ReturnFields: ibclient.NewEmptyRecordCNAME().ReturnFields(),
+		ParseValue:   parseHostname,
+		Normalize:    NormalizeName,
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			return ibclient.NewRecordCNAME(spec.View, spec.Value, spec.Name, spec.TTL != 0, uint32(spec.TTL), "", spec.Ea, ""), nil
+		},
+		Decode: decodeRecords[RecordCNAME],
+	})
+	RegisterRecordType(&RecordType{
+		Type:         Type_TXT,
+		ObjectType:   "record:txt",
+		ReturnFields: ibclient.NewRecordTXT(ibclient.RecordTXT{}).ReturnFields(),
+		ParseValue:   func(value string) (string, error) { return SplitTXT(value), nil },
+		FormatValue:  SplitTXT,
+		Normalize:    JoinTXT,
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			return ibclient.NewRecordTXT(ibclient.RecordTXT{
+				Name:   spec.Name,
+				View:   spec.View,
+				Text:   spec.Value,
+				Ttl:    uint(spec.TTL),
+				UseTtl: spec.TTL != 0,
+				Ea:     spec.Ea,
+			}), nil
+		},
+		Decode: decodeRecords[RecordTXT],
+	})
+	RegisterRecordType(&RecordType{
+		Type:         Type_NS,
+		ObjectType:   "record:ns",
+		ReturnFields: ibclient.NewRecordNS(ibclient.RecordNS{}).ReturnFields(),
+		ParseValue:   parseHostname,
+		Normalize:    NormalizeName,
+		// record:ns objects inherit the TTL of the zone, so only the glue addresses are compared
+		Matches: func(record Record, spec RecordSpec) bool {
+			var addresses []string
+			for _, a := range record.(*RecordNS).Addresses {
+				addresses = append(addresses, NormalizeIP(a.Address))
+			}
+			sort.Strings(addresses)
+			return strings.Join(addresses, ",") == strings.Join(glueAddresses(spec.Glue, record.GetValue()), ",")
+		},
+		Validate: func(spec RecordSpec) error {
+			if needsGlue(spec.Name, spec.Value) && len(glueAddresses(spec.Glue, spec.Value)) == 0 {
+				return fmt.Errorf("name server %s is within the delegated domain %s and needs glue addresses", spec.Value, spec.Name)
+			}
+			return nil
+		},
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			var addresses []ibclient.ZoneNameServer
+			for _, ip := range glueAddresses(spec.Glue, spec.Value) {
+				addresses = append(addresses, ibclient.ZoneNameServer{Address: ip})
+			}
+			return ibclient.NewRecordNS(ibclient.RecordNS{
+				Name:       spec.Name,
+				View:       spec.View,
+				Nameserver: spec.Value,
+				Addresses:  addresses,
+			}), nil
+		},
+		Decode: decodeRecords[RecordNS],
+	})
+	RegisterRecordType(&RecordType{
+		Type:         Type_ALIAS,
+		ObjectType:   "record:alias",
+		ReturnFields: (&RecordAlias{}).ReturnFields(),
+		ParseValue:   parseHostname,
+		Normalize:    NormalizeName,
+		Matches: func(record Record, spec RecordSpec) bool {
+			return matchesTTL(record, spec) && record.(*RecordAlias).TargetType == spec.TargetType
+		},
+		Validate: func(spec RecordSpec) error {
+			if !containsString(AliasTargetTypes, spec.TargetType) {
+				return fmt.Errorf("target type %s not supported for alias records, must be one of %v", spec.TargetType, AliasTargetTypes)
+			}
+			return nil
+		},
+		New: func(_ context.Context, spec RecordSpec) (ibclient.IBObject, error) {
+			r := NewRecordAlias(spec.View, spec.Name, spec.Value, spec.TargetType, spec.TTL)
+			r.Ea = spec.Ea
+			return r, nil
+		},
+		Decode: decodeRecords[RecordAlias],
+	})
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
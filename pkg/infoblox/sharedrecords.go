@@ -0,0 +1,158 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// SharedRecordTypes are the record types which can be managed as records of an Infoblox shared record group.
+var SharedRecordTypes = []string{Type_A, Type_AAAA, Type_CNAME, Type_TXT}
+
+// SharedRecordGroup is an Infoblox shared record group (sharedrecordgroup). Its shared records appear in all
+// associated zones.
+type SharedRecordGroup struct {
+	Ref              string            `json:"_ref,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	ZoneAssociations []ZoneAssociation `json:"zone_associations,omitempty"`
+}
+
+// ZoneAssociation is a zone associated with a shared record group.
+type ZoneAssociation struct {
+	Fqdn string `json:"fqdn"`
+	View string `json:"view,omitempty"`
+}
+
+func (g *SharedRecordGroup) ObjectType() string          { return "sharedrecordgroup" }
+func (g *SharedRecordGroup) ReturnFields() []string      { return []string{"name", "zone_associations"} }
+func (g *SharedRecordGroup) EaSearch() ibclient.EASearch { return nil }
+
+// IsAssociatedWith returns true if the group is associated with the zone with the given FQDN.
+// The view is only compared if it is not empty.
+func (g *SharedRecordGroup) IsAssociatedWith(fqdn, view string) bool {
+	for _, z := range g.ZoneAssociations {
+		if EqualNames(z.Fqdn, fqdn) && (view == "" || z.View == "" || z.View == view) {
+			return true
+		}
+	}
+	return false
+}
+
+// SharedRecord is a record of a shared record group (sharedrecord:a, sharedrecord:aaaa, sharedrecord:cname or
+// sharedrecord:txt). Its name is relative to the zones associated with the group.
+type SharedRecord struct {
+	Type              string `json:"-"`
+	Ref               string `json:"_ref,omitempty"`
+	Name              string `json:"name"`
+	SharedRecordGroup string `json:"shared_record_group,omitempty"`
+	Ipv4Addr          string `json:"ipv4addr,omitempty"`
+	Ipv6Addr          string `json:"ipv6addr,omitempty"`
+	Canonical         string `json:"canonical,omitempty"`
+	Text              string `json:"text,omitempty"`
+	UseTtl            bool   `json:"use_ttl"`
+	Ttl               uint32 `json:"ttl,omitempty"`
+}
+
+// NewSharedRecord returns a shared record of the given record type, which must be one of SharedRecordTypes.
+func NewSharedRecord(recordType, group, name, value string, ttl int64) (*SharedRecord, error) {
+	if _, err := SharedRecordObjectType(recordType); err != nil {
+		return nil, err
+	}
+	r := &SharedRecord{Type: recordType, Name: name, SharedRecordGroup: group}
+	r.SetValue(value)
+	r.SetTTL(int(ttl))
+	return r, nil
+}
+
+// SharedRecordObjectType returns the WAPI object type of shared records of the given record type.
+func SharedRecordObjectType(recordType string) (string, error) {
+	if !containsString(SharedRecordTypes, recordType) {
+		return "", fmt.Errorf("record type %s not supported for shared records, must be one of %v", recordType, SharedRecordTypes)
+	}
+	return "sharedrecord:" + strings.ToLower(recordType), nil
+}
+
+func (r *SharedRecord) ObjectType() string {
+	t, _ := SharedRecordObjectType(r.Type)
+	return t
+}
+
+func (r *SharedRecord) ReturnFields() []string {
+	fields := []string{"name", "shared_record_group", "ttl", "use_ttl"}
+	switch r.Type {
+	case Type_A:
+		fields = append(fields, "ipv4addr")
+	case Type_AAAA:
+		fields = append(fields, "ipv6addr")
+	case Type_CNAME:
+		fields = append(fields, "canonical")
+	case Type_TXT:
+		fields = append(fields, "text")
+	}
+	return fields
+}
+
+func (r *SharedRecord) EaSearch() ibclient.EASearch { return nil }
+
+// GetValue returns the value of the record in its DNSRecord representation.
+func (r *SharedRecord) GetValue() string {
+	switch r.Type {
+	case Type_A:
+		return r.Ipv4Addr
+	case Type_AAAA:
+		return r.Ipv6Addr
+	case Type_CNAME:
+		return r.Canonical
+	case Type_TXT:
+		return SplitTXT(r.Text)
+	}
+	return ""
+}
+
+// SetValue sets the value of the record, which must already be parsed for its record type.
+func (r *SharedRecord) SetValue(value string) {
+	switch r.Type {
+	case Type_A:
+		r.Ipv4Addr = value
+	case Type_AAAA:
+		r.Ipv6Addr = value
+	case Type_CNAME:
+		r.Canonical = value
+	case Type_TXT:
+		r.Text = value
+	}
+}
+
+func (r *SharedRecord) GetTTL() int    { return int(r.Ttl) }
+func (r *SharedRecord) SetTTL(ttl int) { r.Ttl = uint32(ttl); r.UseTtl = ttl != 0 }
+
+// RelativeName returns the name of a shared record for the given name in the given zone. It is empty for the zone apex.
+// It returns false if the name is not in the zone.
+func RelativeName(name, zone string) (string, bool) {
+	name, zone = NormalizeName(name), NormalizeName(zone)
+	if name == zone {
+		return "", true
+	}
+	if !strings.HasSuffix(name, "."+zone) {
+		return "", false
+	}
+	return strings.TrimSuffix(name, "."+zone), true
+}
+
+var _ ibclient.IBObject = (*SharedRecordGroup)(nil)
+var _ ibclient.IBObject = (*SharedRecord)(nil)
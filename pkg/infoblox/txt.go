@@ -0,0 +1,124 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoblox
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTXTStringLength is the maximum length in bytes of a single character-string of a TXT record.
+const MaxTXTStringLength = 255
+
+// SplitTXT converts a TXT value into the representation stored in Infoblox: a space separated list of
+// quoted character-strings of at most MaxTXTStringLength bytes each, with embedded quotes and backslashes escaped.
+// A value which already consists of quoted character-strings is joined first and split again.
+func SplitTXT(value string) string {
+	content := JoinTXT(value)
+	if content == "" {
+		return `""`
+	}
+
+	var parts []string
+	for len(content) > 0 {
+		cut := len(content)
+		if cut > MaxTXTStringLength {
+			cut = MaxTXTStringLength
+			// do not split in the middle of a multi-byte character
+			for cut > 0 && !utf8.RuneStart(content[cut]) {
+				cut--
+			}
+			if cut == 0 {
+				cut = MaxTXTStringLength
+			}
+		}
+		parts = append(parts, quoteCharacterString(content[:cut]))
+		content = content[cut:]
+	}
+	return strings.Join(parts, " ")
+}
+
+// JoinTXT returns the unescaped content of a TXT value. If the value consists of quoted character-strings,
+// they are concatenated, otherwise the value is returned unchanged.
+func JoinTXT(value string) string {
+	strs, ok := parseCharacterStrings(value)
+	if !ok {
+		return value
+	}
+	return strings.Join(strs, "")
+}
+
+func quoteCharacterString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// parseCharacterStrings parses a space separated list of quoted character-strings. Backslash escapes,
+// including the decimal form \DDD, are resolved. It returns false if the value is not in this form.
+func parseCharacterStrings(value string) ([]string, bool) {
+	s := strings.TrimSpace(value)
+	if s == "" {
+		return nil, false
+	}
+
+	var strs []string
+	for len(s) > 0 {
+		if s[0] != '"' {
+			return nil, false
+		}
+		var b strings.Builder
+		closed := false
+		i := 1
+		for i < len(s) {
+			c := s[i]
+			if c == '\\' && i+1 < len(s) {
+				if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+					if n := int(s[i+1]-'0')*100 + int(s[i+2]-'0')*10 + int(s[i+3]-'0'); n <= 255 {
+						b.WriteByte(byte(n))
+						i += 4
+						continue
+					}
+				}
+				b.WriteByte(s[i+1])
+				i += 2
+				continue
+			}
+			i++
+			if c == '"' {
+				closed = true
+				break
+			}
+			b.WriteByte(c)
+		}
+		if !closed {
+			return nil, false
+		}
+		strs = append(strs, b.String())
+		s = strings.TrimLeft(s[i:], " \t")
+	}
+	return strs, true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,114 @@
+package validation_test
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/apis/infoblox/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	fldPath := field.NewPath("providerConfig")
+
+	It("should allow alias records of supported target types", func() {
+		config := &infoblox.DNSRecordConfig{Alias: &infoblox.AliasConfig{TargetType: "AAAA"}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeCNAME, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid unsupported alias target types", func() {
+		config := &infoblox.DNSRecordConfig{Alias: &infoblox.AliasConfig{TargetType: "CNAME"}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeCNAME, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.alias.targetType"),
+			})),
+		))
+	})
+
+	It("should forbid alias records for other record types", func() {
+		config := &infoblox.DNSRecordConfig{Alias: &infoblox.AliasConfig{TargetType: "A"}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeA, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.alias"),
+			})),
+		))
+	})
+
+	It("should allow glue addresses of name servers for NS records", func() {
+		config := &infoblox.DNSRecordConfig{NameServers: []infoblox.NameServer{{Name: "ns1.sub.example.com", Addresses: []string{"10.0.0.1", "fd00::1"}}}}
+		Expect(validation.ValidateDNSRecordConfig(config, "NS", fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid name servers for other record types", func() {
+		config := &infoblox.DNSRecordConfig{NameServers: []infoblox.NameServer{{Name: "ns1.sub.example.com", Addresses: []string{"10.0.0.1"}}}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeA, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.nameServers"),
+			})),
+		))
+	})
+
+	It("should forbid name servers without name or with invalid addresses", func() {
+		config := &infoblox.DNSRecordConfig{NameServers: []infoblox.NameServer{{Addresses: []string{"10.0.0"}}, {Name: "ns2.sub.example.com"}}}
+		Expect(validation.ValidateDNSRecordConfig(config, "NS", fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.nameServers[0].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.nameServers[0].addresses[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.nameServers[1].addresses"),
+			})),
+		))
+	})
+
+	It("should forbid host records and address allocation for other record types", func() {
+		config := &infoblox.DNSRecordConfig{Host: &infoblox.HostConfig{}, NextAvailableIP: &infoblox.NextAvailableIPConfig{Network: "10.0.0.0/24"}}
+		Expect(validation.ValidateDNSRecordConfig(config, "AAAA", fldPath)).To(BeEmpty())
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeTXT, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.host"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.nextAvailableIP"),
+			})),
+		))
+	})
+
+	It("should allow shared records of supported record types", func() {
+		config := &infoblox.DNSRecordConfig{SharedRecord: &infoblox.SharedRecordConfig{Group: "shared"}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeTXT, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid shared records of other record types", func() {
+		config := &infoblox.DNSRecordConfig{SharedRecord: &infoblox.SharedRecordConfig{Group: "shared"}}
+		Expect(validation.ValidateDNSRecordConfig(config, "NS", fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.sharedRecord"),
+			})),
+		))
+	})
+
+	It("should forbid shared records combined with host records", func() {
+		config := &infoblox.DNSRecordConfig{SharedRecord: &infoblox.SharedRecordConfig{}, Host: &infoblox.HostConfig{}}
+		Expect(validation.ValidateDNSRecordConfig(config, extensionsv1alpha1.DNSRecordTypeA, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.sharedRecord"),
+			})),
+		))
+	})
+})
@@ -1,6 +1,8 @@
 package integration_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
@@ -8,7 +10,7 @@ import (
 )
 
 var _ = Describe("CreateTxtRecord", func() {
-	var zone map[string]string
+	var zone dnsInfoBlox.Zone
 	var default_zone string
 	var user string
 	var password string
@@ -42,17 +44,15 @@ var _ = Describe("CreateTxtRecord", func() {
 		Expect(Host).NotTo(BeNil())
 		Expect(Host).NotTo(Equal(""))
 
-		dnsC, err := dnsInfoBlox.NewDNSClient(nil, user, password, Host)
+		dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), user, password, Host, dnsInfoBlox.ClientOptions{})
 		Expect(err).To(BeNil())
 
-		zones, err := dnsC.GetManagedZones(nil)
-		Ω(zones).Should(ContainElement(ContainSubstring(default_zone), &zone))
-		for k := range zone {
-			value = k
-		}
+		zones, err := dnsC.GetManagedZones(context.TODO(), "")
+		Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(default_zone)), &zone))
+		value = zone.FQDN
 		Expect(err).To(BeNil())
 
-		err2 := dnsC.CreateOrUpdateRecordSet(nil, dns_view, value, txt_record_name+"."+value, "TXT", id_addr, 30)
+		err2 := dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, dnsInfoBlox.ZoneID{View: dns_view, FQDN: value}, txt_record_name+"."+value, "TXT", id_addr, 30)
 		Expect(err2).To(BeNil())
 	})
 
@@ -0,0 +1,61 @@
+package integration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	cfg "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/test/config"
+)
+
+var _ = Describe("ForeignARecord", func() {
+	var zone dnsInfoBlox.Zone
+	const a_record_name = "foreign"
+	const dns_view = "default"
+	Context("foreign records next to managed records ::::----", func() {
+		It("Should find and replace foreign A records :", func() {
+			config := cfg.GetConfig()
+			Expect(config.Username).NotTo(BeEmpty())
+			Expect(config.Password).NotTo(BeEmpty())
+			Expect(config.DefaultZone).NotTo(BeEmpty())
+			Expect(config.Host).NotTo(BeEmpty())
+
+			dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{})
+			Expect(err).To(BeNil())
+
+			zones, err := dnsC.GetManagedZones(context.TODO(), dns_view)
+			Expect(err).To(BeNil())
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(config.DefaultZone)), &zone))
+			name := a_record_name + "." + zone.FQDN
+
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.17"}, 30)).To(Succeed())
+			records := dnsC.ManagedRecords()
+			Expect(records).To(HaveLen(1))
+
+			// a record with the same name and type is written by others
+			foreignC, err := dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{})
+			Expect(err).To(BeNil())
+			Expect(foreignC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.17", "10.16.2.19"}, 30)).To(Succeed())
+
+			// the foreign record is found next to the known reference, which is regarded as written by the extension
+			dnsC, err = dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{
+				OwnerAttribute: dnsInfoBlox.DefaultOwnerAttribute,
+				ManagedRecords: records,
+			})
+			Expect(err).To(BeNil())
+			_, err = dnsC.AdoptRecords(context.TODO(), zone.ZoneID, name, "A", dnsInfoBlox.ConflictPolicyFail)
+			Expect(err).To(HaveField("Foreign", ConsistOf("10.16.2.19")))
+
+			// the foreign record is replaced when the record set is written again
+			Expect(dnsC.AdoptRecords(context.TODO(), zone.ZoneID, name, "A", dnsInfoBlox.ConflictPolicyOverwrite)).To(Equal(0))
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.17"}, 30)).To(Succeed())
+			Expect(dnsC.ManagedRecords()).To(ConsistOf(HaveField("Ref", records[0].Ref)))
+			drift, err := dnsC.GetRecordSetDrift(context.TODO(), zone.ZoneID, dnsInfoBlox.DesiredRecordSet{Name: name, RecordType: "A", Values: []string{"10.16.2.17"}, TTL: 30})
+			Expect(err).To(BeNil())
+			Expect(drift).To(BeNil())
+
+			Expect(dnsC.DeleteRecordSet(context.TODO(), zone.ZoneID, name, "A")).To(Succeed())
+		})
+	})
+})
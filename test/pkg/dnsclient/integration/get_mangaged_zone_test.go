@@ -1,6 +1,8 @@
 package integration
 
 import (
+	"context"
+
 	// "fmt"
 	// ibclient "github.com/infobloxopen/infoblox-go-client"
 	. "github.com/onsi/ginkgo/v2"
@@ -10,7 +12,7 @@ import (
 )
 
 var _ = Describe("GetManagedZone", func() {
-	var zone map[string]string
+	var zone dnsInfoBlox.Zone
 	var default_zone string
 	var user string
 	var password string
@@ -41,11 +43,11 @@ var _ = Describe("GetManagedZone", func() {
 			Expect(Host).NotTo(BeNil())
 			Expect(Host).NotTo(Equal(""))
 
-			dnsC, err := dnsInfoBlox.NewDNSClient(nil, user, password, Host)
+			dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), user, password, Host, dnsInfoBlox.ClientOptions{})
 			Expect(err).To(BeNil())
 
-			zones, err := dnsC.GetManagedZones(nil)
-			Ω(zones).Should(ContainElement(ContainSubstring(default_zone), &zone))
+			zones, err := dnsC.GetManagedZones(context.TODO(), "")
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(default_zone)), &zone))
 			Expect(err).To(BeNil())
 		})
 	})
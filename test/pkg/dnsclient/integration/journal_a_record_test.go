@@ -0,0 +1,55 @@
+package integration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	cfg "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/test/config"
+)
+
+var _ = Describe("JournalARecord", func() {
+	var zone dnsInfoBlox.Zone
+	const a_record_name = "journal"
+	const dns_view = "default"
+	Context("change journaling ::::----", func() {
+		It("Should journal and undo the replacement of an A record :", func() {
+			config := cfg.GetConfig()
+			Expect(config.Username).NotTo(BeEmpty())
+			Expect(config.Password).NotTo(BeEmpty())
+			Expect(config.DefaultZone).NotTo(BeEmpty())
+			Expect(config.Host).NotTo(BeEmpty())
+
+			var changes []dnsInfoBlox.RecordSetChange
+			dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{
+				Journal: func(_ context.Context, change dnsInfoBlox.RecordSetChange) error {
+					changes = append(changes, change)
+					return nil
+				},
+			})
+			Expect(err).To(BeNil())
+
+			zones, err := dnsC.GetManagedZones(context.TODO(), dns_view)
+			Expect(err).To(BeNil())
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(config.DefaultZone)), &zone))
+			name := a_record_name + "." + zone.FQDN
+
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.15"}, 30)).To(Succeed())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Previous).To(BeEmpty())
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.16"}, 30)).To(Succeed())
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[1].Previous).To(ConsistOf(HaveField("Value", "10.16.2.15")))
+			Expect(changes[1].Values).To(ConsistOf("10.16.2.16"))
+
+			// undoing the change restores the previous record, which is replaced again by the next write
+			Expect(dnsC.UndoRecordSetChange(context.TODO(), changes[1])).To(Succeed())
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.16"}, 30)).To(Succeed())
+			Expect(changes).To(HaveLen(3))
+			Expect(changes[2].Previous).To(ConsistOf(HaveField("Value", "10.16.2.15")))
+
+			Expect(dnsC.DeleteRecordSet(context.TODO(), zone.ZoneID, name, "A")).To(Succeed())
+		})
+	})
+})
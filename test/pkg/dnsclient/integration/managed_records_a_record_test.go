@@ -0,0 +1,52 @@
+package integration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	cfg "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/test/config"
+)
+
+var _ = Describe("ManagedARecord", func() {
+	var zone dnsInfoBlox.Zone
+	const a_record_name = "managed"
+	const dns_view = "default"
+	Context("managed records ::::----", func() {
+		It("Should update A records by their references :", func() {
+			config := cfg.GetConfig()
+			Expect(config.Username).NotTo(BeEmpty())
+			Expect(config.Password).NotTo(BeEmpty())
+			Expect(config.DefaultZone).NotTo(BeEmpty())
+			Expect(config.Host).NotTo(BeEmpty())
+
+			dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{})
+			Expect(err).To(BeNil())
+
+			zones, err := dnsC.GetManagedZones(context.TODO(), dns_view)
+			Expect(err).To(BeNil())
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(config.DefaultZone)), &zone))
+			name := a_record_name + "." + zone.FQDN
+
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.17"}, 30)).To(Succeed())
+			records := dnsC.ManagedRecords()
+			Expect(records).To(ConsistOf(And(HaveField("Value", "10.16.2.17"), HaveField("TTL", int64(30)), HaveField("Ref", Not(BeEmpty())))))
+
+			// the record set is read by its references and updated
+			dnsC, err = dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{ManagedRecords: records})
+			Expect(err).To(BeNil())
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.18"}, 30)).To(Succeed())
+			Expect(dnsC.ManagedRecords()).To(ConsistOf(HaveField("Value", "10.16.2.18")))
+
+			// a stale reference falls back to discovery
+			dnsC, err = dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{ManagedRecords: records})
+			Expect(err).To(BeNil())
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", []string{"10.16.2.18"}, 30)).To(Succeed())
+			Expect(dnsC.ManagedRecords()).To(ConsistOf(HaveField("Value", "10.16.2.18")))
+
+			Expect(dnsC.DeleteRecordSet(context.TODO(), zone.ZoneID, name, "A")).To(Succeed())
+			Expect(dnsC.ManagedRecords()).To(BeEmpty())
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package integration
 
 import (
+	"context"
+
 	// "fmt"
 	// ibclient "github.com/infobloxopen/infoblox-go-client"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,7 +14,7 @@ import (
 var _ = Describe("NewDnsclient", func() {
 	var dnsClient dnsInfoBlox.DNSClient
 	// var cnfg cfg.Config
-	var zone map[string]string
+	var zone dnsInfoBlox.Zone
 	var default_zone string
 	var user string
 	var password string
@@ -45,44 +47,42 @@ var _ = Describe("NewDnsclient", func() {
 		Expect(Host).NotTo(BeNil())
 		Expect(Host).NotTo(Equal(""))
 
-		dnsC, err := dnsInfoBlox.NewDNSClient(nil, user, password, Host)
+		dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), user, password, Host, dnsInfoBlox.ClientOptions{})
 		dnsClient = dnsC
 		Expect(dnsC).NotTo(BeNil())
 		Expect(err).To(BeNil())
 	})
 	Context("DNSClient go testing", func() {
 		It("GetManaged zone :", func() {
-			zones, err := dnsClient.GetManagedZones(nil)
-			Ω(zones).Should(ContainElement(ContainSubstring(default_zone), &zone))
-			for k := range zone {
-				value = k
-			}
+			zones, err := dnsClient.GetManagedZones(context.TODO(), "")
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(default_zone)), &zone))
+			value = zone.FQDN
 			Expect(err).To(BeNil())
 		})
 		It("Should not create A record :", func() {
-			err := dnsClient.CreateOrUpdateRecordSet(nil, dns_view, value, a_record_name, "A", id_addr, 30)
+			err := dnsClient.CreateOrUpdateRecordSet(context.TODO(), dns_view, dnsInfoBlox.ZoneID{View: dns_view, FQDN: value}, a_record_name, "A", id_addr, 30)
 			Expect(err).NotTo(BeNil())
 		})
 		It("Should create TXT record :", func() {
-			err := dnsClient.CreateOrUpdateRecordSet(nil, dns_view, value, txt_record_name+"."+value, "TXT", id_addr, 30)
+			err := dnsClient.CreateOrUpdateRecordSet(context.TODO(), dns_view, dnsInfoBlox.ZoneID{View: dns_view, FQDN: value}, txt_record_name+"."+value, "TXT", id_addr, 30)
 			Expect(err).To(BeNil())
 		})
 
 		It("Should create CNAME record :", func() {
-			err := dnsClient.CreateOrUpdateRecordSet(nil, dns_view, value, cname_record_name, "CNAME", id_addr, 30)
+			err := dnsClient.CreateOrUpdateRecordSet(context.TODO(), dns_view, dnsInfoBlox.ZoneID{View: dns_view, FQDN: value}, cname_record_name, "CNAME", id_addr, 30)
 			Expect(err).NotTo(BeNil())
 		})
 
 		It("Should delete TXT record :", func() {
-			err := dnsClient.DeleteRecordSet(nil, value, txt_record_name+"."+value, "TXT")
+			err := dnsClient.DeleteRecordSet(context.TODO(), dnsInfoBlox.ZoneID{FQDN: value}, txt_record_name+"."+value, "TXT")
 			Expect(err).To(BeNil())
 		})
 		It("Should delete A record :", func() {
-			err := dnsClient.DeleteRecordSet(nil, value, a_record_name, "A")
+			err := dnsClient.DeleteRecordSet(context.TODO(), dnsInfoBlox.ZoneID{FQDN: value}, a_record_name, "A")
 			Expect(err).To(BeNil())
 		})
 		It("Should delete CNAME record :", func() {
-			err := dnsClient.DeleteRecordSet(nil, value, cname_record_name, "CNAME")
+			err := dnsClient.DeleteRecordSet(context.TODO(), dnsInfoBlox.ZoneID{FQDN: value}, cname_record_name, "CNAME")
 			Expect(err).NotTo(BeNil())
 		})
 	})
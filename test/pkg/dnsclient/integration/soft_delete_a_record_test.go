@@ -0,0 +1,50 @@
+package integration_test
+
+import (
+	"context"
+
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	cfg "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/test/config"
+)
+
+var _ = Describe("SoftDeleteARecord", func() {
+	var zone dnsInfoBlox.Zone
+	const a_record_name = "soft-delete"
+	var id_addr = []string{"10.16.2.14"}
+	const dns_view = "default"
+	Context("soft deletion ::::----", func() {
+		It("Should disable and restore A record :", func() {
+			config := cfg.GetConfig()
+			Expect(config.Username).NotTo(BeEmpty())
+			Expect(config.Password).NotTo(BeEmpty())
+			Expect(config.DefaultZone).NotTo(BeEmpty())
+			Expect(config.Host).NotTo(BeEmpty())
+
+			dnsC, err := dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{
+				SoftDelete: &dnsInfoBlox.SoftDeleteOptions{
+					DeletionAttribute: dnsInfoBlox.DefaultDeletionAttribute,
+					Retention:         time.Hour,
+				},
+			})
+			Expect(err).To(BeNil())
+
+			zones, err := dnsC.GetManagedZones(context.TODO(), dns_view)
+			Expect(err).To(BeNil())
+			Ω(zones).Should(ContainElement(HaveField("ZoneID.FQDN", ContainSubstring(config.DefaultZone)), &zone))
+			name := a_record_name + "." + zone.FQDN
+
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", id_addr, 30)).To(Succeed())
+			Expect(dnsC.DeleteRecordSet(context.TODO(), zone.ZoneID, name, "A")).To(Succeed())
+			// writing the record set again enables the soft-deleted record
+			Expect(dnsC.CreateOrUpdateRecordSet(context.TODO(), dns_view, zone.ZoneID, name, "A", id_addr, 30)).To(Succeed())
+
+			dnsC, err = dnsInfoBlox.NewDNSClient(context.TODO(), config.Username, config.Password, config.Host, dnsInfoBlox.ClientOptions{})
+			Expect(err).To(BeNil())
+			Expect(dnsC.DeleteRecordSet(context.TODO(), zone.ZoneID, name, "A")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package unit_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("Alias records", func() {
+	var (
+		ctx    = context.TODO()
+		zone   = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		conn   *fake.Connector
+		client dnsInfoBlox.DNSClient
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		client = dnsInfoBlox.NewDNSClientFromConnector(conn, "alias-grid", dnsInfoBlox.ClientOptions{})
+	})
+
+	aliasRecords := func() []map[string]interface{} {
+		var records []map[string]interface{}
+		for _, obj := range conn.Objects {
+			if obj["name"] == "example.com" {
+				records = append(records, obj)
+			}
+		}
+		return records
+	}
+
+	It("should create alias records with the target type", func() {
+		Expect(client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "A", []string{"lb.example.com"}, 120)).To(Succeed())
+		Expect(aliasRecords()).To(ConsistOf(And(
+			HaveKeyWithValue("target_name", "lb.example.com"),
+			HaveKeyWithValue("target_type", "A"),
+		)))
+	})
+
+	It("should replace alias records if the target type changes", func() {
+		Expect(client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "A", []string{"lb.example.com"}, 120)).To(Succeed())
+		Expect(client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "AAAA", []string{"lb.example.com"}, 120)).To(Succeed())
+		Expect(aliasRecords()).To(ConsistOf(HaveKeyWithValue("target_type", "AAAA")))
+		Expect(conn.Deleted).To(HaveLen(1))
+	})
+
+	It("should not change existing records for unsupported target types", func() {
+		Expect(client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "A", []string{"lb.example.com"}, 120)).To(Succeed())
+		err := client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "CNAME", []string{"lb2.example.com"}, 120)
+		Expect(err).To(MatchError(ContainSubstring("target type CNAME not supported")))
+		Expect(aliasRecords()).To(ConsistOf(HaveKeyWithValue("target_name", "lb.example.com")))
+		Expect(conn.Deleted).To(BeEmpty())
+	})
+
+	It("should delete alias records of the name", func() {
+		Expect(client.CreateOrUpdateAliasRecordSet(ctx, "default", zone, "example.com", "A", []string{"lb.example.com"}, 120)).To(Succeed())
+		Expect(client.DeleteRecordSet(ctx, zone, "example.com", "ALIAS")).To(Succeed())
+		Expect(aliasRecords()).To(BeEmpty())
+	})
+})
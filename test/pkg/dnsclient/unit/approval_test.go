@@ -0,0 +1,81 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("Approval workflows", func() {
+	It("should return the task of wrapped pending approval errors", func() {
+		err := fmt.Errorf("cannot create record: %w", &dnsInfoBlox.PendingApprovalError{Task: "scheduledtask/b25l:6/PENDING"})
+		task, ok := dnsInfoBlox.PendingApprovalTask(err)
+		Expect(ok).To(BeTrue())
+		Expect(task).To(Equal("scheduledtask/b25l:6/PENDING"))
+
+		_, ok = dnsInfoBlox.PendingApprovalTask(fmt.Errorf("other"))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should report the state of tasks", func() {
+		task := &dnsInfoBlox.Task{ApprovalStatus: dnsInfoBlox.TaskApprovalPending, ExecutionStatus: "PENDING"}
+		Expect(task.IsCompleted()).To(BeFalse())
+		Expect(task.IsRejected()).To(BeFalse())
+
+		task = &dnsInfoBlox.Task{ApprovalStatus: "APPROVED", ExecutionStatus: dnsInfoBlox.TaskExecutionCompleted}
+		Expect(task.IsCompleted()).To(BeTrue())
+
+		task = &dnsInfoBlox.Task{ApprovalStatus: dnsInfoBlox.TaskApprovalRejected, Approver: "admin", ApproverComment: "wrong address"}
+		Expect(task.IsRejected()).To(BeTrue())
+		Expect(task.Reason()).To(Equal("rejected by admin: wrong address"))
+
+		task = &dnsInfoBlox.Task{ApprovalStatus: "APPROVED", ExecutionStatus: dnsInfoBlox.TaskExecutionFailed}
+		Expect(task.IsRejected()).To(BeTrue())
+		Expect(task.Reason()).To(Equal("failed"))
+	})
+
+	Context("with a client", func() {
+		var (
+			ctx    = context.TODO()
+			zone   = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+			conn   *fake.Connector
+			client dnsInfoBlox.DNSClient
+		)
+
+		BeforeEach(func() {
+			conn = fake.NewConnector()
+			client = dnsInfoBlox.NewDNSClientFromConnector(conn, "approval-grid", dnsInfoBlox.ClientOptions{})
+		})
+
+		It("should report creations queued for approval", func() {
+			conn.Queue = true
+			err := client.CreateOrUpdateRecordSet(ctx, "default", zone, "api.example.com", "A", []string{"10.0.0.1"}, 120)
+			task, ok := dnsInfoBlox.PendingApprovalTask(err)
+			Expect(ok).To(BeTrue())
+			Expect(task).To(HavePrefix("scheduledtask/"))
+			Expect(conn.Objects).To(BeEmpty())
+		})
+
+		It("should report deletions queued for approval", func() {
+			conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": "10.0.0.1"})
+			conn.Queue = true
+			err := client.DeleteRecordSet(ctx, zone, "api.example.com", "A")
+			_, ok := dnsInfoBlox.PendingApprovalTask(err)
+			Expect(ok).To(BeTrue())
+			Expect(conn.Objects).To(HaveLen(1))
+		})
+
+		It("should apply changes which are not queued", func() {
+			Expect(client.CreateOrUpdateRecordSet(ctx, "default", zone, "api.example.com", "A", []string{"10.0.0.1"}, 120)).To(Succeed())
+			Expect(conn.Objects).To(HaveLen(1))
+		})
+
+		It("should report tasks which do not exist anymore as nil", func() {
+			Expect(client.GetTask(ctx, "scheduledtask/b25l:1/PENDING")).To(BeNil())
+		})
+	})
+})
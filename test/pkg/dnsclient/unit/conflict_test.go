@@ -0,0 +1,29 @@
+package unit_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+)
+
+var _ = Describe("ConflictError", func() {
+	zone := dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+
+	It("should describe records not written by the extension", func() {
+		err := &dnsInfoBlox.ConflictError{Zone: zone, Name: "www.example.com", RecordType: "A", Foreign: []string{"1.2.3.4"}}
+		Expect(err.Error()).To(Equal("A records www.example.com in zone default/example.com with values [1.2.3.4] have not been written by the extension"))
+	})
+
+	It("should describe records conflicting with CNAME records", func() {
+		err := &dnsInfoBlox.ConflictError{Zone: zone, Name: "www.example.com", RecordType: "CNAME", Types: []string{"A", "TXT"}}
+		Expect(err.Error()).To(ContainSubstring("conflict with existing records of types A, TXT"))
+	})
+
+	It("should detect wrapped conflicts", func() {
+		err := fmt.Errorf("could not write recordset: %w", &dnsInfoBlox.ConflictError{Zone: zone, Name: "www.example.com", RecordType: "A"})
+		Expect(dnsInfoBlox.IsConflict(err)).To(BeTrue())
+		Expect(dnsInfoBlox.IsConflict(fmt.Errorf("connection refused"))).To(BeFalse())
+	})
+})
@@ -0,0 +1,127 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("DeletionBudget", func() {
+	zone := dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+
+	reserve := func(budget *dnsInfoBlox.DeletionBudget, key string, done, n int, enforce bool) error {
+		_, err := budget.Reserve(key, zone, done, n, enforce)
+		return err
+	}
+
+	It("should block deletions exceeding the limit per operation", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(3, 0, time.Hour)
+		Expect(reserve(budget, "grid/default/example.com", 0, 2, true)).To(Succeed())
+		err := reserve(budget, "grid/default/example.com", 2, 2, true)
+		Expect(err).To(MatchError("deleting records in zone default/example.com blocked: 4 deletions exceed the limit of 3 per operation"))
+		Expect(dnsInfoBlox.IsDeletionBudgetExceeded(fmt.Errorf("view default: %w", err))).To(BeTrue())
+	})
+
+	It("should block deletions exceeding the limit per window and zone", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(0, 5, time.Hour)
+		Expect(reserve(budget, "grid/default/example.com", 0, 3, true)).To(Succeed())
+		Expect(reserve(budget, "grid/default/other.com", 0, 3, true)).To(Succeed())
+		err := reserve(budget, "grid/default/example.com", 0, 3, true)
+		Expect(err).To(MatchError("deleting records in zone default/example.com blocked: 6 deletions exceed the limit of 5 per 1h0m0s"))
+	})
+
+	It("should only record approved deletions", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(1, 2, time.Hour)
+		Expect(reserve(budget, "grid/default/example.com", 0, 10, false)).To(Succeed())
+		Expect(dnsInfoBlox.IsDeletionBudgetExceeded(reserve(budget, "grid/default/example.com", 0, 1, true))).To(BeTrue())
+	})
+
+	It("should release deletions", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(0, 2, time.Hour)
+		reservation, err := budget.Reserve("grid/default/example.com", zone, 0, 2, true)
+		Expect(err).NotTo(HaveOccurred())
+		budget.Release(reservation, 1)
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).To(Succeed())
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).NotTo(Succeed())
+	})
+
+	It("should only release deletions of the given reservation", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(0, 2, 100*time.Millisecond)
+		first, err := budget.Reserve("grid/default/example.com", zone, 0, 1, true)
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(60 * time.Millisecond)
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).To(Succeed())
+		budget.Release(first, 2)
+		// the deletion of the second reservation is still in the window
+		time.Sleep(60 * time.Millisecond)
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).To(Succeed())
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).NotTo(Succeed())
+	})
+
+	Context("with a client", func() {
+		var (
+			ctx  = context.TODO()
+			conn *fake.Connector
+		)
+
+		BeforeEach(func() {
+			conn = fake.NewConnector()
+			for _, addr := range []string{"10.0.0.1", "10.0.0.2"} {
+				conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": addr})
+			}
+		})
+
+		It("should count soft deletions against the budget", func() {
+			client := dnsInfoBlox.NewDNSClientFromConnector(conn, "grid", dnsInfoBlox.ClientOptions{
+				SoftDelete:     &dnsInfoBlox.SoftDeleteOptions{DeletionAttribute: dnsInfoBlox.DefaultDeletionAttribute, Retention: time.Hour},
+				DeletionBudget: dnsInfoBlox.NewDeletionBudget(1, 0, time.Hour),
+			})
+			err := client.DeleteRecordSet(ctx, zone, "api.example.com", "A")
+			Expect(dnsInfoBlox.IsDeletionBudgetExceeded(err)).To(BeTrue())
+			for _, obj := range conn.Objects {
+				Expect(obj).NotTo(HaveKey("disable"))
+			}
+		})
+
+		It("should release the budget of failed deletions", func() {
+			budget := dnsInfoBlox.NewDeletionBudget(0, 2, time.Hour)
+			conn.DeleteErr = fmt.Errorf("connection refused")
+			client := dnsInfoBlox.NewDNSClientFromConnector(conn, "grid", dnsInfoBlox.ClientOptions{DeletionBudget: budget})
+			Expect(client.DeleteRecordSet(ctx, zone, "api.example.com", "A")).To(MatchError("connection refused"))
+
+			conn.DeleteErr = nil
+			client = dnsInfoBlox.NewDNSClientFromConnector(conn, "grid", dnsInfoBlox.ClientOptions{DeletionBudget: budget})
+			Expect(client.DeleteRecordSet(ctx, zone, "api.example.com", "A")).To(Succeed())
+			Expect(conn.Deleted).To(HaveLen(2))
+		})
+
+		It("should count purges against the budget", func() {
+			deletedAt := map[string]interface{}{"value": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)}
+			for ref := range conn.Objects {
+				conn.Objects[ref]["disable"] = true
+				conn.Objects[ref]["extattrs"] = map[string]interface{}{dnsInfoBlox.DefaultDeletionAttribute: deletedAt}
+			}
+			client := dnsInfoBlox.NewDNSClientFromConnector(conn, "purge-grid", dnsInfoBlox.ClientOptions{
+				SoftDelete:     &dnsInfoBlox.SoftDeleteOptions{DeletionAttribute: dnsInfoBlox.DefaultDeletionAttribute, Retention: time.Hour},
+				DeletionBudget: dnsInfoBlox.NewDeletionBudget(1, 0, time.Hour),
+			})
+			purged, err := client.PurgeSoftDeletedRecords(ctx, zone)
+			Expect(dnsInfoBlox.IsDeletionBudgetExceeded(err)).To(BeTrue())
+			Expect(purged).To(BeZero())
+			Expect(conn.Deleted).To(BeEmpty())
+		})
+	})
+
+	It("should forget deletions outside of the window", func() {
+		budget := dnsInfoBlox.NewDeletionBudget(0, 1, 50*time.Millisecond)
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).To(Succeed())
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).NotTo(Succeed())
+		time.Sleep(60 * time.Millisecond)
+		Expect(reserve(budget, "grid/default/example.com", 0, 1, true)).To(Succeed())
+	})
+})
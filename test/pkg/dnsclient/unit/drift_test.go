@@ -0,0 +1,138 @@
+package unit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("LookupZonePolicy", func() {
+	policies := map[string]string{
+		"example.com":          "Repair",
+		"internal/example.com": "Report",
+		"internal/example.org": "Repair",
+	}
+
+	It("should prefer the policy of the view-qualified zone", func() {
+		policy, ok := dnsInfoBlox.LookupZonePolicy(policies, dnsInfoBlox.ZoneID{View: "internal", FQDN: "example.com"})
+		Expect(ok).To(BeTrue())
+		Expect(policy).To(Equal("Report"))
+	})
+
+	It("should fall back to the policy of the zone without view", func() {
+		policy, ok := dnsInfoBlox.LookupZonePolicy(policies, dnsInfoBlox.ZoneID{View: "default", FQDN: "Example.com."})
+		Expect(ok).To(BeTrue())
+		Expect(policy).To(Equal("Repair"))
+	})
+
+	It("should not match zones of other views or other zones", func() {
+		_, ok := dnsInfoBlox.LookupZonePolicy(policies, dnsInfoBlox.ZoneID{View: "default", FQDN: "example.org"})
+		Expect(ok).To(BeFalse())
+		_, ok = dnsInfoBlox.LookupZonePolicy(policies, dnsInfoBlox.ZoneID{View: "internal", FQDN: "sub.example.com"})
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("RecordSetDrift", func() {
+	It("should describe all differences", func() {
+		drift := &dnsInfoBlox.RecordSetDrift{
+			Missing:    []string{"1.2.3.4"},
+			Unexpected: []string{"5.6.7.8"},
+			Mismatched: []string{"9.9.9.9"},
+		}
+		Expect(drift.String()).To(Equal("missing values [1.2.3.4], unexpected values [5.6.7.8], values with other TTL or target type [9.9.9.9]"))
+	})
+})
+
+var _ = Describe("GetRecordSetDrift", func() {
+	var (
+		ctx    = context.TODO()
+		zone   = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		conn   *fake.Connector
+		client dnsInfoBlox.DNSClient
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		client = dnsInfoBlox.NewDNSClientFromConnector(conn, "drift-grid", dnsInfoBlox.ClientOptions{})
+	})
+
+	addRecord := func(objectType string, fields map[string]interface{}) string {
+		record := map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ttl": 120, "use_ttl": true}
+		for k, v := range fields {
+			record[k] = v
+		}
+		return conn.Add(objectType, record)
+	}
+
+	desired := func(values ...string) dnsInfoBlox.DesiredRecordSet {
+		return dnsInfoBlox.DesiredRecordSet{Name: "api.example.com", RecordType: "A", Values: values, TTL: 120}
+	}
+
+	It("should not report matching record sets", func() {
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.1"})
+		addRecord("record:a", map[string]interface{}{"name": "www.example.com", "ipv4addr": "10.0.0.2"})
+
+		Expect(client.GetRecordSetDrift(ctx, zone, desired("10.0.0.1"))).To(BeNil())
+	})
+
+	It("should report missing and unexpected values", func() {
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.1"})
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.3"})
+
+		Expect(client.GetRecordSetDrift(ctx, zone, desired("10.0.0.1", "10.0.0.2"))).To(Equal(&dnsInfoBlox.RecordSetDrift{
+			Missing:    []string{"10.0.0.2"},
+			Unexpected: []string{"10.0.0.3"},
+		}))
+	})
+
+	It("should report values with another TTL", func() {
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.1", "ttl": 300})
+
+		Expect(client.GetRecordSetDrift(ctx, zone, desired("10.0.0.1"))).To(Equal(&dnsInfoBlox.RecordSetDrift{Mismatched: []string{"10.0.0.1"}}))
+	})
+
+	It("should report alias records with another target type", func() {
+		addRecord("record:alias", map[string]interface{}{"target_name": "lb.example.com", "target_type": "A"})
+		alias := dnsInfoBlox.DesiredRecordSet{Name: "api.example.com", RecordType: "CNAME", Values: []string{"lb.example.com"}, TTL: 120, TargetType: "AAAA"}
+
+		Expect(client.GetRecordSetDrift(ctx, zone, alias)).To(Equal(&dnsInfoBlox.RecordSetDrift{Mismatched: []string{"lb.example.com"}}))
+	})
+
+	It("should report records disabled in the grid as missing", func() {
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.1", "disable": true})
+
+		Expect(client.GetRecordSetDrift(ctx, zone, desired("10.0.0.1"))).To(Equal(&dnsInfoBlox.RecordSetDrift{Missing: []string{"10.0.0.1"}}))
+	})
+
+	It("should report soft-deleted records as missing", func() {
+		client = dnsInfoBlox.NewDNSClientFromConnector(conn, "drift-grid", dnsInfoBlox.ClientOptions{
+			SoftDelete: &dnsInfoBlox.SoftDeleteOptions{DeletionAttribute: "Gardener Deleted", Retention: time.Hour},
+		})
+		addRecord("record:a", map[string]interface{}{"ipv4addr": "10.0.0.1", "disable": true,
+			"extattrs": map[string]interface{}{"Gardener Deleted": map[string]interface{}{"value": time.Now().UTC().Format(time.RFC3339)}}})
+
+		Expect(client.GetRecordSetDrift(ctx, zone, desired("10.0.0.1"))).To(Equal(&dnsInfoBlox.RecordSetDrift{Missing: []string{"10.0.0.1"}}))
+	})
+
+	It("should compare the addresses of host records", func() {
+		addRecord("record:host", map[string]interface{}{"ipv4addrs": []interface{}{map[string]interface{}{"ipv4addr": "10.0.0.1"}}})
+		host := desired("10.0.0.1", "10.0.0.2")
+		host.Host = true
+
+		Expect(client.GetRecordSetDrift(ctx, zone, host)).To(Equal(&dnsInfoBlox.RecordSetDrift{Missing: []string{"10.0.0.2"}}))
+	})
+
+	It("should compare shared records", func() {
+		conn.Add("sharedrecordgroup", map[string]interface{}{"name": "shared", "zone_associations": []interface{}{map[string]interface{}{"fqdn": "example.com", "view": "default"}}})
+		conn.Add("sharedrecord:a", map[string]interface{}{"name": "api", "shared_record_group": "shared", "ipv4addr": "10.0.0.1", "ttl": 60, "use_ttl": true})
+		shared := desired("10.0.0.1")
+		shared.Shared, shared.SharedRecordGroup = true, "shared"
+
+		Expect(client.GetRecordSetDrift(ctx, zone, shared)).To(Equal(&dnsInfoBlox.RecordSetDrift{Mismatched: []string{"10.0.0.1"}}))
+	})
+})
@@ -0,0 +1,57 @@
+package unit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("UndoRecordSetChange", func() {
+	var (
+		ctx    = context.TODO()
+		zone   = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		conn   *fake.Connector
+		client dnsInfoBlox.DNSClient
+		change dnsInfoBlox.RecordSetChange
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		client = dnsInfoBlox.NewDNSClientFromConnector(conn, "journal-grid", dnsInfoBlox.ClientOptions{ScheduledAt: time.Now().Add(time.Hour)})
+		change = dnsInfoBlox.RecordSetChange{
+			Zone:       zone,
+			View:       "default",
+			Name:       "api.example.com",
+			RecordType: "A",
+			Previous:   []dnsInfoBlox.JournaledRecord{{Ref: "record:a/ZG5zprevious:api.example.com", Value: "10.0.0.1", TTL: 120}},
+			Values:     []string{"10.0.0.2"},
+			TTL:        120,
+		}
+	})
+
+	It("should restore the previous records immediately even if changes are scheduled", func() {
+		written := conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": "10.0.0.2", "ttl": 120, "use_ttl": true})
+
+		Expect(client.UndoRecordSetChange(ctx, change)).To(Succeed())
+		Expect(conn.Deleted).To(ConsistOf(written))
+		Expect(conn.Objects).To(ContainElement(HaveKeyWithValue("ipv4addr", "10.0.0.1")))
+		Expect(client.ScheduledTasks()).To(BeEmpty())
+
+		// later changes are still scheduled
+		Expect(client.CreateOrUpdateRecordSet(ctx, "default", zone, "www.example.com", "A", []string{"10.0.0.3"}, 120)).To(Succeed())
+		Expect(client.ScheduledTasks()).NotTo(BeEmpty())
+	})
+
+	It("should keep previous records which still exist", func() {
+		previous := conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": "10.0.0.1", "ttl": 120, "use_ttl": true})
+
+		Expect(client.UndoRecordSetChange(ctx, change)).To(Succeed())
+		Expect(conn.Deleted).To(BeEmpty())
+		Expect(conn.Objects).To(HaveLen(1))
+		Expect(conn.Objects).To(HaveKey(previous))
+	})
+})
@@ -0,0 +1,59 @@
+package unit_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("Managed records", func() {
+	var (
+		ctx  = context.TODO()
+		zone = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		conn *fake.Connector
+		ref  string
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		ref = conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": "10.0.0.1", "ttl": 120, "use_ttl": true})
+	})
+
+	newClient := func(refs ...string) dnsInfoBlox.DNSClient {
+		var records []dnsInfoBlox.ManagedRecord
+		for _, r := range refs {
+			records = append(records, dnsInfoBlox.ManagedRecord{Zone: zone, Ref: r, View: "default", Name: "api.example.com", RecordType: "A", Value: "10.0.0.1"})
+		}
+		return dnsInfoBlox.NewDNSClientFromConnector(conn, "managed-grid", dnsInfoBlox.ClientOptions{ManagedRecords: records})
+	}
+
+	It("should read the record set by the references of its managed records", func() {
+		// a record added later is not read, as the record set is not searched by name
+		other := conn.Add("record:a", map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": "10.0.0.2", "ttl": 120, "use_ttl": true})
+		client := newClient(ref)
+
+		Expect(client.CreateOrUpdateRecordSet(ctx, "default", zone, "api.example.com", "A", []string{"10.0.0.1"}, 120)).To(Succeed())
+		Expect(conn.Objects).To(HaveKey(other))
+		Expect(conn.Deleted).To(BeEmpty())
+		Expect(client.ManagedRecords()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"Ref": Equal(ref)})))
+
+		Expect(client.DeleteRecordSet(ctx, zone, "api.example.com", "A")).To(Succeed())
+		Expect(conn.Deleted).To(ConsistOf(ref))
+		Expect(conn.Objects).To(HaveKey(other))
+	})
+
+	It("should drop stale references and discover the record set by name", func() {
+		client := newClient("record:a/ZG5zstale:api.example.com")
+
+		Expect(client.CreateOrUpdateRecordSet(ctx, "default", zone, "api.example.com", "A", []string{"10.0.0.1", "10.0.0.2"}, 120)).To(Succeed())
+		Expect(conn.Objects).To(HaveKey(ref))
+		Expect(conn.Deleted).To(BeEmpty())
+		Expect(client.ManagedRecords()).To(HaveLen(2))
+		Expect(client.ManagedRecords()).To(ContainElement(MatchFields(IgnoreExtras, Fields{"Ref": Equal(ref), "Value": Equal("10.0.0.1")})))
+		Expect(client.ManagedRecords()).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"Ref": Equal("record:a/ZG5zstale:api.example.com")})))
+	})
+})
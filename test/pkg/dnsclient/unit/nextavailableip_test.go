@@ -0,0 +1,100 @@
+package unit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("AllocationOptions", func() {
+	It("should allocate from networks and ranges", func() {
+		Expect(dnsInfoBlox.AllocationOptions{Network: "10.0.0.0/24"}.NextAvailableIP()).To(Equal("func:nextavailableip:10.0.0.0/24"))
+		Expect(dnsInfoBlox.AllocationOptions{Network: "10.0.0.10-10.0.0.50"}.NextAvailableIP()).To(Equal("func:nextavailableip:10.0.0.10-10.0.0.50"))
+	})
+
+	It("should qualify the network with the network view", func() {
+		alloc := dnsInfoBlox.AllocationOptions{Network: "10.0.0.0/24", NetworkView: "lab"}
+		Expect(alloc.NextAvailableIP()).To(Equal("func:nextavailableip:10.0.0.0/24,lab"))
+	})
+})
+
+var _ = Describe("AllocateAddress", func() {
+	var (
+		ctx   = context.TODO()
+		zone  = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		alloc = dnsInfoBlox.AllocationOptions{Network: "10.0.0.0/24"}
+		conn  *fake.Connector
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		conn.NextAvailableIP = "10.0.0.5"
+	})
+
+	addRecord := func(address string, ea map[string]interface{}) string {
+		fields := map[string]interface{}{"name": "api.example.com", "view": "default", "zone": "example.com", "ipv4addr": address}
+		if ea != nil {
+			fields["extattrs"] = ea
+		}
+		return conn.Add("record:a", fields)
+	}
+
+	It("should allocate the next available address", func() {
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.5"))
+		Expect(conn.Objects).To(HaveLen(1))
+	})
+
+	It("should return the address of an existing record", func() {
+		addRecord("10.0.0.2", map[string]interface{}{dnsInfoBlox.DefaultOwnerAttribute: map[string]interface{}{"value": dnsInfoBlox.RecordOwner}})
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{OwnerAttribute: dnsInfoBlox.DefaultOwnerAttribute})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.2"))
+		Expect(conn.Objects).To(HaveLen(1))
+	})
+
+	It("should not return the address of records written by others", func() {
+		addRecord("10.0.0.2", nil)
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{OwnerAttribute: dnsInfoBlox.DefaultOwnerAttribute})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.5"))
+	})
+
+	It("should not return the address of soft-deleted records", func() {
+		deletedAt := map[string]interface{}{"value": time.Now().UTC().Format(time.RFC3339)}
+		addRecord("10.0.0.2", map[string]interface{}{dnsInfoBlox.DefaultDeletionAttribute: deletedAt})
+		for ref := range conn.Objects {
+			conn.Objects[ref]["disable"] = true
+		}
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{
+			SoftDelete: &dnsInfoBlox.SoftDeleteOptions{DeletionAttribute: dnsInfoBlox.DefaultDeletionAttribute, Retention: time.Hour},
+		})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.5"))
+	})
+
+	It("should report allocations queued for approval", func() {
+		conn.Queue = true
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{})
+		_, err := client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)
+		_, ok := dnsInfoBlox.PendingApprovalTask(err)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should not return the address of existing records without owner attribute", func() {
+		addRecord("10.0.0.2", nil)
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.5"))
+		Expect(conn.Objects).To(HaveLen(2))
+	})
+
+	It("should return the address of a managed record without owner attribute", func() {
+		ref := addRecord("10.0.0.2", nil)
+		client := dnsInfoBlox.NewDNSClientFromConnector(conn, "alloc-grid", dnsInfoBlox.ClientOptions{
+			ManagedRecords: []dnsInfoBlox.ManagedRecord{{Zone: zone, Ref: ref, View: "default", Name: "api.example.com", RecordType: "A", Value: "10.0.0.2"}},
+		})
+		Expect(client.AllocateAddress(ctx, "default", zone, "api.example.com", "A", 120, alloc, nil)).To(Equal("10.0.0.2"))
+		Expect(conn.Objects).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,44 @@
+package unit_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("NS records", func() {
+	var (
+		ctx    = context.TODO()
+		zone   = dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+		conn   *fake.Connector
+		client dnsInfoBlox.DNSClient
+		apex   string
+	)
+
+	BeforeEach(func() {
+		conn = fake.NewConnector()
+		client = dnsInfoBlox.NewDNSClientFromConnector(conn, "ns-grid", dnsInfoBlox.ClientOptions{})
+		apex = conn.Add("record:ns", map[string]interface{}{"name": "example.com", "view": "default", "zone": "example.com", "nameserver": "ns1.grid.example.org"})
+	})
+
+	It("should delegate subdomains", func() {
+		Expect(client.CreateOrUpdateNSRecordSet(ctx, "default", zone, "sub.example.com", []string{"ns1.example.org"}, nil)).To(Succeed())
+		Expect(conn.Objects).To(ContainElement(And(HaveKeyWithValue("name", "sub.example.com"), HaveKeyWithValue("nameserver", "ns1.example.org"))))
+		Expect(conn.Objects).To(HaveKey(apex))
+	})
+
+	It("should reject NS records at the zone apex", func() {
+		err := client.CreateOrUpdateNSRecordSet(ctx, "default", zone, "Example.com.", []string{"ns1.example.org"}, nil)
+		Expect(err).To(MatchError(ContainSubstring("apex of zone default/example.com")))
+		Expect(conn.Objects).To(HaveLen(1))
+		Expect(conn.Objects).To(HaveKey(apex))
+	})
+
+	It("should not delete the NS records of the zone apex", func() {
+		Expect(client.DeleteRecordSet(ctx, zone, "example.com", "NS")).To(Succeed())
+		Expect(conn.Deleted).To(BeEmpty())
+	})
+})
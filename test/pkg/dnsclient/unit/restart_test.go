@@ -0,0 +1,120 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	testclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("ServiceRestarter", func() {
+	const minInterval = 10 * time.Minute
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		stopped   chan struct{}
+		clock     *testclock.FakeClock
+		restarter *dnsInfoBlox.ServiceRestarter
+		restarts  int32
+		restart   func(context.Context) error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = testclock.NewFakeClock(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+		restarter = dnsInfoBlox.NewServiceRestarter(clock, minInterval, 2)
+		stopped = make(chan struct{})
+		go func() {
+			defer close(stopped)
+			Expect(restarter.Start(ctx)).To(Succeed())
+		}()
+		atomic.StoreInt32(&restarts, 0)
+		restart = func(context.Context) error {
+			atomic.AddInt32(&restarts, 1)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(stopped).Should(BeClosed())
+	})
+
+	count := func() int32 { return atomic.LoadInt32(&restarts) }
+
+	// step advances the clock once the restarter waits for the next restart.
+	step := func(d time.Duration) {
+		Eventually(clock.HasWaiters).Should(BeTrue())
+		clock.Step(d)
+	}
+
+	It("should restart immediately the first time", func() {
+		restarter.Request("grid", restart)
+		Eventually(count).Should(Equal(int32(1)))
+	})
+
+	It("should coalesce restarts within the minimum interval", func() {
+		restarter.Request("grid", restart)
+		Eventually(count).Should(Equal(int32(1)))
+
+		for i := 0; i < 5; i++ {
+			restarter.Request("grid", restart)
+		}
+		step(minInterval - time.Second)
+		Consistently(count, 50*time.Millisecond).Should(Equal(int32(1)))
+		step(time.Second)
+		Eventually(count).Should(Equal(int32(2)))
+		Consistently(clock.HasWaiters, 50*time.Millisecond).Should(BeFalse())
+	})
+
+	It("should restart grids independently", func() {
+		restarter.Request("grid-1", restart)
+		restarter.Request("grid-2", restart)
+		Eventually(count).Should(Equal(int32(2)))
+	})
+
+	It("should retry failed restarts with backoff", func() {
+		var calls int32
+		restarter.Request("grid", func(context.Context) error {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				return fmt.Errorf("grid unavailable")
+			}
+			return nil
+		})
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+		step(minInterval)
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(2)))
+		step(minInterval)
+		Consistently(func() int32 { return atomic.LoadInt32(&calls) }, 50*time.Millisecond).Should(Equal(int32(2)))
+		step(minInterval)
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(3)))
+	})
+
+	It("should give up after the maximum number of retries", func() {
+		var calls int32
+		restarter.Request("grid", func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			return fmt.Errorf("grid unavailable")
+		})
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(1)))
+		step(minInterval)
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(2)))
+		step(2 * minInterval)
+		Eventually(func() int32 { return atomic.LoadInt32(&calls) }).Should(Equal(int32(3)))
+		Consistently(clock.HasWaiters, 50*time.Millisecond).Should(BeFalse())
+	})
+
+	It("should stop when the context is cancelled", func() {
+		restarter.Request("grid", func(context.Context) error { return fmt.Errorf("grid unavailable") })
+		Eventually(clock.HasWaiters).Should(BeTrue())
+		cancel()
+		Eventually(stopped).Should(BeClosed())
+		Expect(clock.HasWaiters()).To(BeFalse())
+	})
+})
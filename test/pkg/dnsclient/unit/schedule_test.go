@@ -0,0 +1,39 @@
+package unit_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+)
+
+var _ = Describe("NextChangeTime", func() {
+	window := timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(22, 0, 0), timewindow.NewMaintenanceTime(23, 0, 0))
+
+	It("should apply changes immediately without window", func() {
+		Expect(dnsInfoBlox.NextChangeTime(nil, time.Now()).IsZero()).To(BeTrue())
+	})
+
+	It("should apply changes immediately within the window", func() {
+		now := time.Date(2022, 5, 1, 22, 30, 0, 0, time.UTC)
+		Expect(dnsInfoBlox.NextChangeTime(window, now).IsZero()).To(BeTrue())
+	})
+
+	It("should schedule changes before the window for the same day", func() {
+		now := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+		Expect(dnsInfoBlox.NextChangeTime(window, now)).To(Equal(time.Date(2022, 5, 1, 22, 0, 0, 0, time.UTC)))
+	})
+
+	It("should schedule changes after the window for the next day", func() {
+		now := time.Date(2022, 5, 1, 23, 30, 0, 0, time.UTC)
+		Expect(dnsInfoBlox.NextChangeTime(window, now)).To(Equal(time.Date(2022, 5, 2, 22, 0, 0, 0, time.UTC)))
+	})
+
+	It("should handle windows spanning midnight", func() {
+		window := timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(23, 0, 0), timewindow.NewMaintenanceTime(1, 0, 0))
+		Expect(dnsInfoBlox.NextChangeTime(window, time.Date(2022, 5, 2, 0, 30, 0, 0, time.UTC)).IsZero()).To(BeTrue())
+		Expect(dnsInfoBlox.NextChangeTime(window, time.Date(2022, 5, 2, 2, 0, 0, 0, time.UTC))).To(Equal(time.Date(2022, 5, 2, 23, 0, 0, 0, time.UTC)))
+	})
+})
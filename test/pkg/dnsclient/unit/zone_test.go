@@ -0,0 +1,137 @@
+package unit_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+)
+
+var _ = Describe("ZoneID", func() {
+	It("should parse plain FQDNs", func() {
+		zone, err := dnsInfoBlox.ParseZoneID("Example.com.")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone).To(Equal(dnsInfoBlox.ZoneID{FQDN: "example.com"}))
+		Expect(zone.String()).To(Equal("example.com"))
+		Expect(zone.IsQualified()).To(BeFalse())
+	})
+
+	It("should parse view-qualified names", func() {
+		zone, err := dnsInfoBlox.ParseZoneID("internal/example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone).To(Equal(dnsInfoBlox.ZoneID{View: "internal", FQDN: "example.com"}))
+		Expect(zone.String()).To(Equal("internal/example.com"))
+		Expect(zone.IsQualified()).To(BeTrue())
+	})
+
+	It("should parse WAPI references", func() {
+		ref := "zone_auth/ZG5zLnpvbmUkLl9kZWZhdWx0LmNvbS5leGFtcGxl:example.com/default"
+		zone, err := dnsInfoBlox.ParseZoneID(ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone).To(Equal(dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com", Ref: ref}))
+		Expect(zone.String()).To(Equal("default/example.com"))
+	})
+
+	It("should reject invalid zones", func() {
+		for _, s := range []string{"", "/example.com", "internal/", "zone_auth/abc", "zone_auth/abc:example.com"} {
+			_, err := dnsInfoBlox.ParseZoneID(s)
+			Expect(err).To(HaveOccurred(), s)
+		}
+	})
+})
+
+var _ = Describe("FindZoneForName", func() {
+	zones := []dnsInfoBlox.Zone{
+		{ZoneID: dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}, Kind: dnsInfoBlox.ZoneKindAuthoritative},
+		{ZoneID: dnsInfoBlox.ZoneID{View: "default", FQDN: "shoot.example.com"}, Kind: dnsInfoBlox.ZoneKindAuthoritative},
+		{ZoneID: dnsInfoBlox.ZoneID{View: "internal", FQDN: "internal.example.com"}, Kind: dnsInfoBlox.ZoneKindAuthoritative},
+		{ZoneID: dnsInfoBlox.ZoneID{View: "default", FQDN: "team.example.com"}, Kind: dnsInfoBlox.ZoneKindDelegated},
+		{ZoneID: dnsInfoBlox.ZoneID{View: "default", FQDN: "sub.team.example.com"}, Kind: dnsInfoBlox.ZoneKindAuthoritative},
+	}
+
+	It("should pick the longest matching authoritative zone", func() {
+		zone, err := dnsInfoBlox.FindZoneForName(zones, "api.foo.shoot.example.com", "default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone.FQDN).To(Equal("shoot.example.com"))
+	})
+
+	It("should only consider zones of the given view", func() {
+		_, err := dnsInfoBlox.FindZoneForName(zones, "api.internal.example.com", "external")
+		Expect(err).To(HaveOccurred())
+		zone, err := dnsInfoBlox.FindZoneForName(zones, "api.internal.example.com", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone.View).To(Equal("internal"))
+	})
+
+	It("should not pick a zone hidden by a delegation", func() {
+		_, err := dnsInfoBlox.FindZoneForName(zones, "api.team.example.com", "default")
+		Expect(err).To(MatchError(ContainSubstring("delegated zone default/team.example.com")))
+		Expect(dnsInfoBlox.IsZoneNotFound(err)).To(BeFalse())
+	})
+
+	It("should report a missing zone as not found", func() {
+		_, err := dnsInfoBlox.FindZoneForName(zones, "api.example.org", "default")
+		Expect(dnsInfoBlox.IsZoneNotFound(err)).To(BeTrue())
+	})
+
+	It("should pick an authoritative zone below a delegation", func() {
+		zone, err := dnsInfoBlox.FindZoneForName(zones, "api.sub.team.example.com", "default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone.FQDN).To(Equal("sub.team.example.com"))
+	})
+})
+
+var _ = Describe("ZoneToCreate", func() {
+	parents := []string{"shoot.example.com", "internal/lab.example.com", "team.shoot.example.com"}
+
+	It("should return the domain one label below the parent domain", func() {
+		zone, ok := dnsInfoBlox.ZoneToCreate("api.foo.shoot.example.com", "default", parents)
+		Expect(ok).To(BeTrue())
+		Expect(zone).To(Equal(dnsInfoBlox.ZoneID{View: "default", FQDN: "foo.shoot.example.com"}))
+
+		zone, ok = dnsInfoBlox.ZoneToCreate("foo.shoot.example.com", "default", parents)
+		Expect(ok).To(BeTrue())
+		Expect(zone.FQDN).To(Equal("foo.shoot.example.com"))
+	})
+
+	It("should use the longest parent domain", func() {
+		zone, ok := dnsInfoBlox.ZoneToCreate("*.ingress.bar.team.shoot.example.com", "default", parents)
+		Expect(ok).To(BeTrue())
+		Expect(zone.FQDN).To(Equal("bar.team.shoot.example.com"))
+	})
+
+	It("should return the parent domain for names equal to it", func() {
+		zone, ok := dnsInfoBlox.ZoneToCreate("Shoot.Example.com.", "default", parents)
+		Expect(ok).To(BeTrue())
+		Expect(zone.FQDN).To(Equal("shoot.example.com"))
+	})
+
+	It("should respect view-qualified parent domains", func() {
+		_, ok := dnsInfoBlox.ZoneToCreate("api.foo.lab.example.com", "default", parents)
+		Expect(ok).To(BeFalse())
+
+		zone, ok := dnsInfoBlox.ZoneToCreate("api.foo.lab.example.com", "internal", parents)
+		Expect(ok).To(BeTrue())
+		Expect(zone).To(Equal(dnsInfoBlox.ZoneID{View: "internal", FQDN: "foo.lab.example.com"}))
+	})
+
+	It("should not create zones outside of the parent domains or for wildcards", func() {
+		_, ok := dnsInfoBlox.ZoneToCreate("api.example.org", "default", parents)
+		Expect(ok).To(BeFalse())
+
+		_, ok = dnsInfoBlox.ZoneToCreate("*.shoot.example.com", "default", parents)
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("ParseViews", func() {
+	It("should split, trim and de-duplicate views", func() {
+		views, err := dnsInfoBlox.ParseViews(" internal, external,,internal ")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(views).To(Equal([]string{"internal", "external"}))
+	})
+
+	It("should reject empty lists", func() {
+		_, err := dnsInfoBlox.ParseViews(" , ")
+		Expect(err).To(HaveOccurred())
+	})
+})
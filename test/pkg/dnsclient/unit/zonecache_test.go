@@ -0,0 +1,131 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+)
+
+var _ = Describe("ZoneCache", func() {
+	var (
+		ctx   = context.TODO()
+		calls int32
+		zones = []dnsInfoBlox.Zone{{ZoneID: dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}, Kind: dnsInfoBlox.ZoneKindAuthoritative}}
+		list  = func(context.Context) ([]dnsInfoBlox.Zone, error) {
+			atomic.AddInt32(&calls, 1)
+			return zones, nil
+		}
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&calls, 0)
+	})
+
+	It("should serve zones from the cache until they are invalidated", func() {
+		cache := dnsInfoBlox.NewZoneCache(time.Hour)
+		for i := 0; i < 3; i++ {
+			res, err := cache.Get(ctx, "default", list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(zones))
+		}
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+
+		_, err := cache.Get(ctx, "internal", list)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+
+		cache.Invalidate("default")
+		_, err = cache.Get(ctx, "default", list)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+	})
+
+	It("should not cache zones with a zero TTL", func() {
+		cache := dnsInfoBlox.NewZoneCache(0)
+		for i := 0; i < 3; i++ {
+			_, err := cache.Get(ctx, "default", list)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+	})
+
+	It("should not cache errors", func() {
+		cache := dnsInfoBlox.NewZoneCache(time.Hour)
+		_, err := cache.Get(ctx, "default", func(context.Context) ([]dnsInfoBlox.Zone, error) {
+			return nil, fmt.Errorf("grid unavailable")
+		})
+		Expect(err).To(MatchError("grid unavailable"))
+
+		res, err := cache.Get(ctx, "default", list)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(zones))
+	})
+
+	It("should de-duplicate concurrent refreshes", func() {
+		cache := dnsInfoBlox.NewZoneCache(time.Hour)
+		started, release := make(chan struct{}), make(chan struct{})
+		var once sync.Once
+		blocking := func(ctx context.Context) ([]dnsInfoBlox.Zone, error) {
+			once.Do(func() { close(started) })
+			<-release
+			return list(ctx)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				res, err := cache.Get(ctx, "default", blocking)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(zones))
+			}()
+		}
+		Eventually(started).Should(BeClosed())
+		close(release)
+		wg.Wait()
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+	})
+
+	It("should not fail waiting callers if the caller starting the refresh is cancelled", func() {
+		cache := dnsInfoBlox.NewZoneCache(time.Hour)
+		started, release := make(chan struct{}), make(chan struct{})
+		blocking := func(ctx context.Context) ([]dnsInfoBlox.Zone, error) {
+			close(started)
+			<-release
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return list(ctx)
+		}
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		firstErr := make(chan error)
+		go func() {
+			_, err := cache.Get(cancelCtx, "default", blocking)
+			firstErr <- err
+		}()
+		Eventually(started).Should(BeClosed())
+
+		waiting := make(chan []dnsInfoBlox.Zone)
+		go func() {
+			defer GinkgoRecover()
+			res, err := cache.Get(ctx, "default", blocking)
+			Expect(err).NotTo(HaveOccurred())
+			waiting <- res
+		}()
+
+		cancel()
+		Eventually(firstErr).Should(Receive(MatchError(context.Canceled)))
+		close(release)
+		Eventually(waiting).Should(Receive(Equal(zones)))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,78 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("ZoneLockedError", func() {
+	zone := dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"}
+
+	It("should name the admin holding the lock", func() {
+		err := &dnsInfoBlox.ZoneLockedError{Zone: zone, LockedBy: "admin"}
+		Expect(err).To(MatchError("zone default/example.com is locked by admin"))
+	})
+
+	It("should report disabled zones", func() {
+		err := &dnsInfoBlox.ZoneLockedError{Zone: zone, Disabled: true}
+		Expect(err).To(MatchError("zone default/example.com is disabled"))
+	})
+
+	It("should be detected when wrapped", func() {
+		err := fmt.Errorf("view default: %w", &dnsInfoBlox.ZoneLockedError{Zone: zone})
+		Expect(dnsInfoBlox.IsZoneLocked(err)).To(BeTrue())
+		Expect(dnsInfoBlox.IsZoneLocked(fmt.Errorf("other"))).To(BeFalse())
+	})
+
+	Context("with a client", func() {
+		var (
+			ctx    = context.TODO()
+			conn   *fake.Connector
+			client dnsInfoBlox.DNSClient
+		)
+
+		BeforeEach(func() {
+			conn = fake.NewConnector()
+			client = dnsInfoBlox.NewDNSClientFromConnector(conn, "lock-grid", dnsInfoBlox.ClientOptions{})
+		})
+
+		It("should allow writing unlocked zones", func() {
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "locked": false, "disable": false})
+			Expect(client.CheckZoneWritable(ctx, zone)).To(Succeed())
+		})
+
+		It("should detect locked zones", func() {
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "locked": true, "locked_by": "admin"})
+			err := client.CheckZoneWritable(ctx, zone)
+			Expect(dnsInfoBlox.IsZoneLocked(err)).To(BeTrue())
+			Expect(err).To(MatchError("zone default/example.com is locked by admin"))
+		})
+
+		It("should detect disabled zones by reference", func() {
+			ref := conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "disable": true})
+			err := client.CheckZoneWritable(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com", Ref: ref})
+			Expect(err).To(MatchError("zone default/example.com is disabled"))
+		})
+
+		It("should drop the cached zones if the zone does not exist anymore", func() {
+			ref := conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "zone_format": "FORWARD"})
+			Expect(client.GetManagedZones(ctx, "default")).To(HaveLen(1))
+			delete(conn.Objects, ref)
+			Expect(client.GetManagedZones(ctx, "default")).To(HaveLen(1))
+
+			Expect(dnsInfoBlox.IsZoneNotFound(client.CheckZoneWritable(ctx, zone))).To(BeTrue())
+			Expect(client.GetManagedZones(ctx, "default")).To(BeEmpty())
+		})
+
+		It("should only check the zone of the given view", func() {
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "internal", "locked": true, "locked_by": "admin"})
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default"})
+			Expect(client.CheckZoneWritable(ctx, zone)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package unit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dnsInfoBlox "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient"
+	"github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/dnsclient/fake"
+)
+
+var _ = Describe("ZoneSerials", func() {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	It("should report unverified zones and changed serials", func() {
+		serials := dnsInfoBlox.NewZoneSerials(0)
+		Expect(serials.Changed("default/example.com", 1, now)).To(BeTrue())
+
+		serials.Verified("default/example.com", 1, now)
+		Expect(serials.Changed("default/example.com", 1, now.Add(48*time.Hour))).To(BeFalse())
+		Expect(serials.Changed("default/example.com", 2, now)).To(BeTrue())
+		Expect(serials.Changed("internal/example.com", 1, now)).To(BeTrue())
+	})
+
+	It("should report zones verified longer than the maximum age ago", func() {
+		serials := dnsInfoBlox.NewZoneSerials(time.Hour)
+		serials.Verified("default/example.com", 1, now)
+		Expect(serials.Changed("default/example.com", 1, now.Add(59*time.Minute))).To(BeFalse())
+		Expect(serials.Changed("default/example.com", 1, now.Add(time.Hour))).To(BeTrue())
+	})
+
+	It("should forget zones", func() {
+		serials := dnsInfoBlox.NewZoneSerials(0)
+		serials.Verified("default/example.com", 1, now)
+		serials.Verified("default/example.org", 1, now)
+		serials.Forget(func(key string) bool { return key == "default/example.org" })
+		Expect(serials.Changed("default/example.com", 1, now)).To(BeTrue())
+		Expect(serials.Changed("default/example.org", 1, now)).To(BeFalse())
+	})
+
+	Context("with a client", func() {
+		var (
+			ctx    = context.TODO()
+			conn   *fake.Connector
+			client dnsInfoBlox.DNSClient
+		)
+
+		BeforeEach(func() {
+			conn = fake.NewConnector()
+			client = dnsInfoBlox.NewDNSClientFromConnector(conn, "serial-grid", dnsInfoBlox.ClientOptions{})
+		})
+
+		It("should return the serial of authoritative zones", func() {
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "soa_serial_number": 42, "primary_type": "Grid"})
+			Expect(client.GetZoneSerial(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"})).To(Equal(uint32(42)))
+		})
+
+		It("should not return serials of zones with an external primary server", func() {
+			conn.Add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default", "soa_serial_number": 42, "primary_type": "External"})
+			_, err := client.GetZoneSerial(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"})
+			Expect(dnsInfoBlox.IsNoZoneSerial(err)).To(BeTrue())
+		})
+
+		It("should not return serials of delegated and forward zones", func() {
+			conn.Add("zone_delegated", map[string]interface{}{"fqdn": "sub.example.com", "view": "default"})
+			ref := conn.Add("zone_forward", map[string]interface{}{"fqdn": "fwd.example.com", "view": "default"})
+			_, err := client.GetZoneSerial(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "sub.example.com"})
+			Expect(dnsInfoBlox.IsNoZoneSerial(err)).To(BeTrue())
+			_, err = client.GetZoneSerial(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "fwd.example.com", Ref: ref})
+			Expect(dnsInfoBlox.IsNoZoneSerial(err)).To(BeTrue())
+		})
+
+		It("should report unknown zones", func() {
+			_, err := client.GetZoneSerial(ctx, dnsInfoBlox.ZoneID{View: "default", FQDN: "example.com"})
+			Expect(dnsInfoBlox.IsZoneNotFound(err)).To(BeTrue())
+		})
+	})
+})
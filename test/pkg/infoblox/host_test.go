@@ -0,0 +1,46 @@
+package infoblox_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("RecordHost", func() {
+	host := &raw.RecordHost{
+		Ref:             "record:host/ZG5z:api.example.com/default",
+		Name:            "api.example.com",
+		View:            "default",
+		Zone:            "example.com",
+		NetworkView:     "default",
+		ConfigureForDNS: true,
+		Ipv4Addrs: []raw.HostAddress{
+			{Ref: "record:host_ipv4addr/ZG5z:10.0.0.1/api.example.com/default", Ipv4Addr: "10.0.0.1"},
+		},
+	}
+
+	It("should select the addresses by record type", func() {
+		Expect(host.Addresses(raw.Type_A)).To(HaveLen(1))
+		Expect(host.Addresses(raw.Type_AAAA)).To(BeEmpty())
+		Expect(host.Addresses(raw.Type_A)[0].GetValue()).To(Equal("10.0.0.1"))
+	})
+
+	It("should only send updatable fields and replace both address lists", func() {
+		update := host.PrepareUpdate()
+		update.SetTTL(300)
+
+		data, err := json.Marshal(update)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{
+			"name": "api.example.com",
+			"configure_for_dns": true,
+			"ipv4addrs": [{"ipv4addr": "10.0.0.1", "configure_for_dhcp": false}],
+			"ipv6addrs": [],
+			"use_ttl": true,
+			"ttl": 300
+		}`))
+		Expect(host.Ipv4Addrs[0].Ref).NotTo(BeEmpty())
+	})
+})
@@ -0,0 +1,13 @@
+package infoblox_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestInfoblox(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Infoblox Suite")
+}
@@ -0,0 +1,31 @@
+package infoblox_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("Names", func() {
+	It("should escape wildcards for WAPI", func() {
+		Expect(raw.ToWAPIName("*.ingress.example.com.")).To(Equal("\\052.ingress.example.com"))
+		Expect(raw.ToUnicodeName("\\052.ingress.example.com")).To(Equal("*.ingress.example.com"))
+	})
+
+	It("should convert internationalized names to punycode and back", func() {
+		Expect(raw.ToWAPIName("api.Müller.de")).To(Equal("api.xn--mller-kva.de"))
+		Expect(raw.ToUnicodeName("api.xn--mller-kva.de.")).To(Equal("api.müller.de"))
+		Expect(raw.EqualNames("api.müller.de", "api.xn--mller-kva.de")).To(BeTrue())
+	})
+
+	It("should keep underscore labels", func() {
+		Expect(raw.ToWAPIName("_acme-challenge.example.com")).To(Equal("_acme-challenge.example.com"))
+	})
+
+	It("should reject invalid labels", func() {
+		for _, name := range []string{"", "a..example.com", "-a.example.com", "a b.example.com", "a.*.example.com", "*"} {
+			_, err := raw.ToWAPIName(name)
+			Expect(err).To(HaveOccurred(), name)
+		}
+	})
+})
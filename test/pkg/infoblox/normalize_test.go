@@ -0,0 +1,29 @@
+package infoblox_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("Normalize", func() {
+	It("should compare names case-insensitively and ignore the trailing dot", func() {
+		Expect(raw.EqualNames("API.Example.com.", "api.example.com")).To(BeTrue())
+		Expect(raw.EqualNames("\\052.ingress.example.com", "*.ingress.example.com")).To(BeTrue())
+		Expect(raw.EqualNames("a.example.com", "b.example.com")).To(BeFalse())
+	})
+
+	It("should compare IPv6 addresses in compressed form", func() {
+		Expect(raw.EqualValues(raw.Type_AAAA, "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1")).To(BeTrue())
+		Expect(raw.EqualValues(raw.Type_A, "10.0.0.1", "10.0.0.2")).To(BeFalse())
+	})
+
+	It("should compare host name values case-insensitively", func() {
+		Expect(raw.EqualValues(raw.Type_CNAME, "LB.example.com.", "lb.example.com")).To(BeTrue())
+	})
+
+	It("should compare quoted and unquoted texts", func() {
+		Expect(raw.EqualValues(raw.Type_TXT, "\"some text\"", "some text")).To(BeTrue())
+		Expect(raw.EqualValues(raw.Type_TXT, "some text", "Some text")).To(BeFalse())
+	})
+})
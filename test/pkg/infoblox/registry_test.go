@@ -0,0 +1,91 @@
+package infoblox_test
+
+import (
+	"context"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("Registry", func() {
+	It("should know all built-in record types", func() {
+		Expect(raw.SupportedRecordTypes()).To(Equal([]string{"A", "AAAA", "ALIAS", "CNAME", "NS", "TXT"}))
+	})
+
+	It("should reject unknown record types", func() {
+		_, err := raw.LookupRecordType("MX")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should validate values", func() {
+		rt, err := raw.LookupRecordType(raw.Type_A)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = rt.ParseValue("2001:db8::1")
+		Expect(err).To(HaveOccurred())
+		Expect(rt.ParseValue("10.0.0.1")).To(Equal("10.0.0.1"))
+	})
+
+	It("should create WAPI objects", func() {
+		rt, err := raw.LookupRecordType(raw.Type_CNAME)
+		Expect(err).NotTo(HaveOccurred())
+		obj, err := rt.New(context.TODO(), raw.RecordSpec{View: "default", Name: "api.example.com", Value: "lb.example.com", TTL: 120})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.ObjectType()).To(Equal("record:cname"))
+		Expect(obj.(*ibclient.RecordCNAME).Canonical).To(Equal("lb.example.com"))
+		Expect(obj.(*ibclient.RecordCNAME).Ttl).To(Equal(uint32(120)))
+	})
+
+	It("should reject alias records with unsupported target types", func() {
+		rt, err := raw.LookupRecordType(raw.Type_ALIAS)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rt.Validate(raw.RecordSpec{Name: "example.com", Value: "lb.example.com", TargetType: "CNAME"})).NotTo(Succeed())
+		Expect(rt.Validate(raw.RecordSpec{Name: "example.com", Value: "lb.example.com", TargetType: "A"})).To(Succeed())
+	})
+
+	It("should require glue addresses for name servers within the delegated domain", func() {
+		rt, err := raw.LookupRecordType(raw.Type_NS)
+		Expect(err).NotTo(HaveOccurred())
+		glue := map[string][]string{"ns1.sub.example.com.": {"10.0.0.1"}}
+		Expect(rt.Validate(raw.RecordSpec{Name: "sub.example.com", Value: "ns2.sub.example.com", Glue: glue})).NotTo(Succeed())
+		Expect(rt.Validate(raw.RecordSpec{Name: "sub.example.com", Value: "ns1.sub.example.com", Glue: glue})).To(Succeed())
+		Expect(rt.Validate(raw.RecordSpec{Name: "sub.example.com", Value: "ns.example.net"})).To(Succeed())
+	})
+
+	It("should create NS records with the glue addresses of the provider config", func() {
+		rt, err := raw.LookupRecordType(raw.Type_NS)
+		Expect(err).NotTo(HaveOccurred())
+		glue := map[string][]string{"ns1.sub.example.com": {"10.0.0.2", "10.0.0.1"}}
+		obj, err := rt.New(context.TODO(), raw.RecordSpec{View: "default", Name: "sub.example.com", Value: "ns1.sub.example.com", Glue: glue})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*ibclient.RecordNS).Addresses).To(Equal([]ibclient.ZoneNameServer{{Address: "10.0.0.1"}, {Address: "10.0.0.2"}}))
+		obj, err = rt.New(context.TODO(), raw.RecordSpec{View: "default", Name: "sub.example.com", Value: "ns.example.net", Glue: glue})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*ibclient.RecordNS).Addresses).To(BeEmpty())
+	})
+
+	It("should compare the glue addresses of NS records", func() {
+		rt, err := raw.LookupRecordType(raw.Type_NS)
+		Expect(err).NotTo(HaveOccurred())
+		records, err := rt.Decode([]byte(`[{"_ref":"record:ns/abc:sub.example.com/default","name":"sub.example.com","nameserver":"ns1.sub.example.com","addresses":[{"address":"10.0.0.2"},{"address":"10.0.0.1"}]}]`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		spec := raw.RecordSpec{Name: "sub.example.com", Glue: map[string][]string{"ns1.sub.example.com": {"10.0.0.1", "10.0.0.2"}}}
+		Expect(rt.Matches(records[0], spec)).To(BeTrue())
+		spec.Glue = map[string][]string{"ns1.sub.example.com": {"10.0.0.1"}}
+		Expect(rt.Matches(records[0], spec)).To(BeFalse())
+	})
+
+	It("should decode WAPI responses", func() {
+		rt, err := raw.LookupRecordType(raw.Type_A)
+		Expect(err).NotTo(HaveOccurred())
+		records, err := rt.Decode([]byte(`[{"_ref":"record:a/abc:api.example.com/default","name":"api.example.com","ipv4addr":"10.0.0.1","ttl":120,"use_ttl":true}]`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].GetType()).To(Equal(raw.Type_A))
+		Expect(records[0].GetId()).To(Equal("record:a/abc:api.example.com/default"))
+		Expect(records[0].GetValue()).To(Equal("10.0.0.1"))
+		Expect(records[0].GetTTL()).To(Equal(120))
+	})
+})
@@ -0,0 +1,65 @@
+package infoblox_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("SharedRecord", func() {
+	It("should map record types to shared record object types", func() {
+		t, err := raw.SharedRecordObjectType(raw.Type_AAAA)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t).To(Equal("sharedrecord:aaaa"))
+
+		_, err = raw.SharedRecordObjectType(raw.Type_NS)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should write the value field of the record type", func() {
+		r, err := raw.NewSharedRecord(raw.Type_A, "common", "www", "10.0.0.1", 300)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.ObjectType()).To(Equal("sharedrecord:a"))
+		Expect(r.ReturnFields()).To(ContainElement("ipv4addr"))
+		Expect(r.GetValue()).To(Equal("10.0.0.1"))
+
+		data, err := json.Marshal(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{
+			"name": "www",
+			"shared_record_group": "common",
+			"ipv4addr": "10.0.0.1",
+			"use_ttl": true,
+			"ttl": 300
+		}`))
+	})
+
+	It("should return names relative to the zone", func() {
+		name, ok := raw.RelativeName("WWW.Example.com.", "example.com")
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("www"))
+
+		name, ok = raw.RelativeName("example.com", "example.com")
+		Expect(ok).To(BeTrue())
+		Expect(name).To(BeEmpty())
+
+		_, ok = raw.RelativeName("www.example.org", "example.com")
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("SharedRecordGroup", func() {
+	group := &raw.SharedRecordGroup{
+		Name:             "common",
+		ZoneAssociations: []raw.ZoneAssociation{{Fqdn: "example.com", View: "internal"}},
+	}
+
+	It("should match associated zones", func() {
+		Expect(group.IsAssociatedWith("example.com", "internal")).To(BeTrue())
+		Expect(group.IsAssociatedWith("example.com", "")).To(BeTrue())
+		Expect(group.IsAssociatedWith("example.com", "external")).To(BeFalse())
+		Expect(group.IsAssociatedWith("example.org", "internal")).To(BeFalse())
+	})
+})
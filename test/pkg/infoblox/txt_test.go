@@ -0,0 +1,43 @@
+package infoblox_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	raw "github.com/ujwaliyer/gardener-extension-provider-dns-infoblox/pkg/infoblox"
+)
+
+var _ = Describe("TXT", func() {
+	It("should quote short values", func() {
+		Expect(raw.SplitTXT("owner-id")).To(Equal(`"owner-id"`))
+		Expect(raw.SplitTXT(`"owner-id"`)).To(Equal(`"owner-id"`))
+		Expect(raw.SplitTXT("")).To(Equal(`""`))
+	})
+
+	It("should escape quotes and backslashes", func() {
+		Expect(raw.SplitTXT(`say "hi" \o/`)).To(Equal(`"say \"hi\" \\o/"`))
+		Expect(raw.JoinTXT(`"say \"hi\" \\o/"`)).To(Equal(`say "hi" \o/`))
+	})
+
+	It("should split long values into character-strings", func() {
+		value := "v=DKIM1; k=rsa; p=" + strings.Repeat("A", 400)
+		split := raw.SplitTXT(value)
+		Expect(split).To(Equal(`"` + value[:255] + `" "` + value[255:] + `"`))
+		Expect(raw.JoinTXT(split)).To(Equal(value))
+	})
+
+	It("should not split multi-byte characters", func() {
+		value := strings.Repeat("a", 254) + "ü"
+		Expect(raw.SplitTXT(value)).To(Equal(`"` + strings.Repeat("a", 254) + `" "ü"`))
+	})
+
+	It("should resolve decimal escapes", func() {
+		Expect(raw.JoinTXT(`"a\034b" "c"`)).To(Equal(`a"bc`))
+	})
+
+	It("should compare split and unsplit values", func() {
+		value := strings.Repeat("x", 300)
+		Expect(raw.EqualValues(raw.Type_TXT, value, raw.SplitTXT(value))).To(BeTrue())
+	})
+})